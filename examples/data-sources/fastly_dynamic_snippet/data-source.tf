@@ -0,0 +1,8 @@
+data "fastly_dynamic_snippet" "example" {
+  service_id = "SU1Z0isxPaozGVKXdv0eY"
+  snippet_id = "62Yd1WfiCBPENLloXfXmlO"
+}
+
+output "snippet_content" {
+  value = data.fastly_dynamic_snippet.example.content
+}
@@ -0,0 +1,5 @@
+data "fastly_kv_stores" "example" {}
+
+output "kv_store_ids" {
+  value = { for s in data.fastly_kv_stores.example.stores : s.name => s.id }
+}
@@ -0,0 +1,5 @@
+data "fastly_secret_store_client_key" "example" {}
+
+output "client_key" {
+  value = data.fastly_secret_store_client_key.example.client_key
+}
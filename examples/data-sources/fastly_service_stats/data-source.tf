@@ -0,0 +1,8 @@
+data "fastly_service_stats" "example" {
+  service_id = fastly_service_vcl.example.id
+  from       = "one week ago"
+}
+
+output "weekly_hit_ratio" {
+  value = data.fastly_service_stats.example.hit_ratio
+}
@@ -0,0 +1,9 @@
+data "fastly_stats" "example" {
+  service_id = fastly_service_vcl.example.id
+  from       = "one week ago"
+  by         = "day"
+}
+
+output "daily_5xx" {
+  value = [for s in data.fastly_stats.example.stats : s.status_5xx]
+}
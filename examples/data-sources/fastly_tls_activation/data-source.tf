@@ -0,0 +1,7 @@
+data "fastly_tls_activation" "example" {
+  domain = "www.example.com"
+}
+
+output "certificate_id" {
+  value = data.fastly_tls_activation.example.certificate_id
+}
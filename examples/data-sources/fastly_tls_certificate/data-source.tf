@@ -0,0 +1,7 @@
+data "fastly_tls_certificate" "example" {
+  domain = "www.example.com"
+}
+
+output "certificate_expires" {
+  value = data.fastly_tls_certificate.example.not_after
+}
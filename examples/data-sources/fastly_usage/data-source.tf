@@ -0,0 +1,8 @@
+data "fastly_usage" "example" {
+  year  = "2024"
+  month = "05"
+}
+
+output "estimated_cost" {
+  value = data.fastly_usage.example.billing.cost
+}
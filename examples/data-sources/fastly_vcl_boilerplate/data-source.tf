@@ -0,0 +1,8 @@
+data "fastly_vcl_boilerplate" "example" {
+  service_id = fastly_service_vcl.example.id
+  version    = fastly_service_vcl.example.version
+}
+
+output "boilerplate" {
+  value = data.fastly_vcl_boilerplate.example.content
+}
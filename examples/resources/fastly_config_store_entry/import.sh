@@ -0,0 +1,2 @@
+# The ID is the store ID and the entry key separated by a forward slash.
+terraform import fastly_config_store_entry.example 7Lsb7Y76rChV9hSrv3KgFl/origin_region
@@ -0,0 +1,5 @@
+resource "fastly_config_store_entry" "example" {
+  store_id = "7Lsb7Y76rChV9hSrv3KgFl"
+  key      = "origin_region"
+  value    = "us-east"
+}
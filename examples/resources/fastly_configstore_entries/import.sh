@@ -0,0 +1,2 @@
+# The ID is the store ID. Every entry in the store is imported.
+terraform import fastly_configstore_entries.example 7Lsb7Y76rChV9hSrv3KgFl
@@ -0,0 +1,9 @@
+resource "fastly_configstore_entries" "example" {
+  store_id     = "7Lsb7Y76rChV9hSrv3KgFl"
+  manage_items = true
+
+  entries = {
+    origin_region = "us-east"
+    feature_flags = "beta,dark-mode"
+  }
+}
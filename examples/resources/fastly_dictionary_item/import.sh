@@ -0,0 +1,2 @@
+# The ID is the service ID, the dictionary ID and the item key separated by forward slashes.
+terraform import fastly_dictionary_item.example SU1Z0isxPaozGVKXdv0eY/3vjTN8v1O7nOAY7aNDGOL/feature_flag
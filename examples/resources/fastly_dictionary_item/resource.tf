@@ -0,0 +1,6 @@
+resource "fastly_dictionary_item" "example" {
+  service_id    = "SU1Z0isxPaozGVKXdv0eY"
+  dictionary_id = "3vjTN8v1O7nOAY7aNDGOL"
+  key           = "feature_flag"
+  value         = "enabled"
+}
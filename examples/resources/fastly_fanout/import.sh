@@ -0,0 +1,2 @@
+# The ID is the ID of the Compute service.
+terraform import fastly_fanout.example SU1Z0isxPaozGVKXdv0eY
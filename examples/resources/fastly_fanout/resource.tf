@@ -0,0 +1,3 @@
+resource "fastly_fanout" "example" {
+  service_id = "SU1Z0isxPaozGVKXdv0eY"
+}
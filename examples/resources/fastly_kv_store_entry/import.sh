@@ -0,0 +1,2 @@
+# The ID is the store ID and the entry key separated by a forward slash.
+terraform import fastly_kv_store_entry.example hl7w9ubh3rmxu3ngbziuzr/feature_flags
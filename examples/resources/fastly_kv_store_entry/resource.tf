@@ -0,0 +1,15 @@
+resource "fastly_kv_store_entry" "example" {
+  store_id     = "hl7w9ubh3rmxu3ngbziuzr"
+  key          = "feature_flags"
+  value        = jsonencode({ dark_mode = true })
+  metadata     = "owner=terraform"
+  time_to_live = 86400
+}
+
+# A large value is streamed from a file (and isn't stored in the state).
+resource "fastly_kv_store_entry" "large" {
+  store_id    = "hl7w9ubh3rmxu3ngbziuzr"
+  key         = "geoip.db"
+  source      = "${path.module}/geoip.db"
+  source_hash = filesha256("${path.module}/geoip.db")
+}
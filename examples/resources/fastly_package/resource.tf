@@ -0,0 +1,6 @@
+resource "fastly_package" "example" {
+  service_id       = "SU1Z0isxPaozGVKXdv0eY"
+  version          = 2
+  filename         = "pkg/example.tar.gz"
+  source_code_hash = filesha512("pkg/example.tar.gz")
+}
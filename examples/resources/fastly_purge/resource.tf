@@ -0,0 +1,9 @@
+resource "fastly_purge" "example" {
+  service_id     = fastly_service_vcl.example.id
+  surrogate_keys = ["products", "pricing"]
+  soft           = true
+
+  triggers = {
+    version = fastly_service_vcl.example.last_active
+  }
+}
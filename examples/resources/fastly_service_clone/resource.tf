@@ -0,0 +1,23 @@
+# The "golden" service holds the configuration shared by every tenant.
+resource "fastly_service_vcl" "golden" {
+  name = "golden"
+
+  domains = {
+    "example" = {
+      name = "www.example.com"
+    }
+  }
+}
+
+# Each tenant service is a copy of the active version of the golden service.
+resource "fastly_service_clone" "tenant" {
+  name              = "tenant-a"
+  source_service_id = fastly_service_vcl.golden.id
+  domains           = ["tenant-a.example.com"]
+}
+
+# The copied version is activated as a separate step.
+resource "fastly_service_promotion" "tenant" {
+  service_id = fastly_service_clone.tenant.id
+  version    = fastly_service_clone.tenant.version
+}
@@ -0,0 +1,2 @@
+# The ID is the service ID and the promoted service version separated by a forward slash.
+terraform import fastly_service_promotion.example SU1Z0isxPaozGVKXdv0eY/2
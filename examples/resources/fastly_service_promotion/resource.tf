@@ -0,0 +1,18 @@
+# The service stages a draft version without activating it.
+resource "fastly_service_vcl" "example" {
+  name     = "example"
+  activate = false
+
+  domains = {
+    "example" = {
+      name = "www.example.com"
+    }
+  }
+}
+
+# A later apply promotes the staged version to production.
+resource "fastly_service_promotion" "example" {
+  service_id     = fastly_service_vcl.example.id
+  version        = fastly_service_vcl.example.cloned_version
+  require_locked = false
+}
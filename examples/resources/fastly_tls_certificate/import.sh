@@ -0,0 +1,2 @@
+# The ID is the TLS certificate ID.
+terraform import fastly_tls_certificate.example cRTc00Mt8xTyhlSOZ0Ngxp
@@ -0,0 +1,4 @@
+resource "fastly_tls_certificate" "example" {
+  name             = "www.example.com"
+  certificate_blob = file("certs/www.example.com.pem")
+}
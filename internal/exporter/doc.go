@@ -0,0 +1,6 @@
+// Package exporter generates Terraform configuration for existing services.
+//
+// It's used to bootstrap the migration of services created outside of
+// Terraform (e.g. via the Fastly UI) and is invoked through the provider
+// binary's `-export-service` flag.
+package exporter
@@ -0,0 +1,373 @@
+package exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/interfaces"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/registry"
+	// The service resource registers its nested resources with the registry.
+	_ "github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/servicevcl"
+)
+
+// ResourceType is the type of the exported resource.
+const ResourceType = "fastly_service_vcl"
+
+// invalidNameChars matches characters not permitted in a Terraform identifier.
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// ServiceVCL returns the HCL for a fastly_service_vcl resource matching the
+// configuration of an existing service, along with an `import` block so the
+// service can be adopted by Terraform (requires Terraform 1.5+).
+//
+// If version is zero, the active service version is exported (falling back to
+// the latest version if the service has never been activated).
+//
+// Every nested resource supported by a VCL service (e.g. domains, dictionaries,
+// logging endpoints) is exported, so an error is returned if a nested resource
+// has an attribute the exporter can't represent.
+//
+// NOTE: The keys of nested map attributes (e.g. domains) are derived from the
+// entity names, as the API has no concept of an ID for these entities.
+func ServiceVCL(api helpers.API, serviceID string, version int32) ([]byte, error) {
+	service, err := serviceDetail(api, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	if service.GetType() != "vcl" {
+		return nil, fmt.Errorf("service '%s' is a '%s' service, only VCL services can be exported", serviceID, service.GetType())
+	}
+
+	version, err = exportVersion(service, version)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := serviceSettings(api, serviceID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	http3, err := serviceHTTP3(api, serviceID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := serviceProducts(api, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	nestedResources := registry.NestedResources(helpers.ServiceTypeVCL)
+	nested, err := nestedValues(api, serviceID, version, nestedResources)
+	if err != nil {
+		return nil, err
+	}
+
+	label := Name(service.GetName())
+	if label == "" {
+		label = "service"
+	}
+
+	f := hclwrite.NewEmptyFile()
+	root := f.Body()
+
+	imp := root.AppendNewBlock("import", nil).Body()
+	imp.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: ResourceType},
+		hcl.TraverseAttr{Name: label},
+	})
+	imp.SetAttributeValue("id", cty.StringVal(fmt.Sprintf("%s@%d", serviceID, version)))
+	root.AppendNewline()
+
+	res := root.AppendNewBlock("resource", []string{ResourceType, label}).Body()
+	res.SetAttributeValue("name", cty.StringVal(service.GetName()))
+	// NOTE: The comment is always set as the schema has a non-empty default.
+	res.SetAttributeValue("comment", cty.StringVal(service.GetComment()))
+
+	if host := settings.GetGeneralDefaultHost(); host != "" {
+		res.SetAttributeValue("default_host", cty.StringVal(host))
+	}
+	if ptr, ok := settings.GetGeneralDefaultTTLOk(); ok {
+		res.SetAttributeValue("default_ttl", cty.NumberIntVal(int64(*ptr)))
+	}
+	if ptr, ok := settings.GetGeneralStaleIfErrorOk(); ok {
+		res.SetAttributeValue("stale_if_error", cty.BoolVal(*ptr))
+	}
+	if ptr, ok := settings.GetGeneralStaleIfErrorTTLOk(); ok {
+		res.SetAttributeValue("stale_if_error_ttl", cty.NumberIntVal(int64(*ptr)))
+	}
+
+	if http3 {
+		res.SetAttributeValue("http3", cty.True)
+	}
+	for _, p := range products {
+		res.SetAttributeValue(p.id, cty.BoolVal(p.enabled))
+	}
+
+	// NOTE: A service can only be activated with at least one domain.
+	if _, ok := nested["domains"]; !ok {
+		res.SetAttributeValue("activate", cty.False)
+	}
+	for _, nestedResource := range nestedResources {
+		if value, ok := nested[nestedResource.Attribute()]; ok {
+			res.SetAttributeValue(nestedResource.Attribute(), value)
+		}
+	}
+
+	return hclwrite.Format(f.Bytes()), nil
+}
+
+// Name returns a Terraform identifier derived from an entity name.
+// e.g. `www.example.com` becomes `www_example_com`.
+func Name(s string) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(s), "_")
+	name = strings.Trim(name, "_")
+	// Identifiers must not start with a digit or hyphen.
+	if name != "" && !(name[0] >= 'a' && name[0] <= 'z') {
+		name = "_" + name
+	}
+	return name
+}
+
+// nestedValues returns the values of the nested resource attributes, keyed by
+// the attribute name. An attribute with no entities is omitted.
+//
+// Each nested resource reads its entities from the service version into a
+// state with only its own attribute, which is then converted to HCL using the
+// schema of the attribute.
+func nestedValues(api helpers.API, serviceID string, version int32, nestedResources []interfaces.Resource) (map[string]cty.Value, error) {
+	ctx := context.Background()
+	schemas := registry.Schemas(helpers.ServiceTypeVCL)
+	values := make(map[string]cty.Value, len(nestedResources))
+
+	for _, nestedResource := range nestedResources {
+		name := nestedResource.Attribute()
+		attribute, ok := schemas[name].(schema.MapNestedAttribute)
+		if !ok {
+			return nil, fmt.Errorf("unable to export '%s': unsupported attribute type %T", name, schemas[name])
+		}
+
+		s := schema.Schema{Attributes: map[string]schema.Attribute{name: attribute}}
+		objectType, ok := s.Type().TerraformType(ctx).(tftypes.Object)
+		if !ok {
+			return nil, fmt.Errorf("unable to export '%s': unexpected schema type", name)
+		}
+		state := tfsdk.State{
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				name: tftypes.NewValue(objectType.AttributeTypes[name], nil),
+			}),
+			Schema: s,
+		}
+
+		req := resource.ReadRequest{State: state}
+		resp := resource.ReadResponse{State: state}
+		if err := nestedResource.Read(ctx, &req, &resp, api, &helpers.Service{ID: serviceID, Version: version}); err != nil {
+			return nil, fmt.Errorf("unable to read '%s': %w", name, err)
+		}
+		if err := diagsError(resp.Diagnostics); err != nil {
+			return nil, fmt.Errorf("unable to read '%s': %w", name, err)
+		}
+
+		var entities types.Map
+		if err := diagsError(req.State.GetAttribute(ctx, path.Root(name), &entities)); err != nil {
+			return nil, fmt.Errorf("unable to read '%s': %w", name, err)
+		}
+		if len(entities.Elements()) == 0 {
+			continue
+		}
+
+		value, err := mapValue(attribute, entities)
+		if err != nil {
+			return nil, fmt.Errorf("unable to export '%s': %w", name, err)
+		}
+		values[name] = value
+	}
+
+	return values, nil
+}
+
+// mapValue returns a nested map attribute value, with each entity keyed by its
+// name.
+//
+// NOTE: Computed-only attributes (e.g. IDs) and null attributes are omitted.
+// Entities whose names produce the same key are given a numeric suffix.
+// A wildcard domain (e.g. *.example.com) is given a `wildcard_` key prefix.
+func mapValue(attribute schema.MapNestedAttribute, entities types.Map) (cty.Value, error) {
+	objects := make([]map[string]attr.Value, 0, len(entities.Elements()))
+	for _, entity := range entities.Elements() {
+		object, ok := entity.(types.Object)
+		if !ok {
+			return cty.NilVal, fmt.Errorf("unexpected entity type %T", entity)
+		}
+		objects = append(objects, object.Attributes())
+	}
+	entityName := func(attrs map[string]attr.Value) string {
+		name, _ := attrs["name"].(types.String)
+		return name.ValueString()
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return entityName(objects[i]) < entityName(objects[j])
+	})
+
+	values := make(map[string]cty.Value, len(objects))
+	for _, attrs := range objects {
+		object := make(map[string]cty.Value, len(attrs))
+		for k, v := range attrs {
+			a := attribute.NestedObject.Attributes[k]
+			if a == nil || (a.IsComputed() && !a.IsOptional() && !a.IsRequired()) || v.IsNull() || v.IsUnknown() {
+				continue
+			}
+			value, err := ctyValue(v)
+			if err != nil {
+				return cty.NilVal, fmt.Errorf("attribute '%s': %w", k, err)
+			}
+			object[k] = value
+		}
+
+		name := Name(entityName(attrs))
+		if helpers.IsWildcardDomain(entityName(attrs)) {
+			name = "wildcard_" + Name(strings.TrimPrefix(entityName(attrs), "*."))
+		}
+		key := name
+		for i := 2; ; i++ {
+			if _, exists := values[key]; !exists {
+				break
+			}
+			key = fmt.Sprintf("%s_%d", name, i)
+		}
+		values[key] = cty.ObjectVal(object)
+	}
+
+	return cty.ObjectVal(values), nil
+}
+
+// ctyValue converts a primitive attribute value to its HCL value.
+func ctyValue(v attr.Value) (cty.Value, error) {
+	switch v := v.(type) {
+	case types.Bool:
+		return cty.BoolVal(v.ValueBool()), nil
+	case types.Int64:
+		return cty.NumberIntVal(v.ValueInt64()), nil
+	case types.String:
+		return cty.StringVal(v.ValueString()), nil
+	default:
+		return cty.NilVal, fmt.Errorf("unsupported attribute type %T", v)
+	}
+}
+
+// diagsError returns the first error diagnostic (if any) as an error.
+func diagsError(diags diag.Diagnostics) error {
+	for _, d := range diags.Errors() {
+		return errors.New(strings.TrimSuffix(fmt.Sprintf("%s: %s", d.Summary(), d.Detail()), ": "))
+	}
+	return nil
+}
+
+// exportVersion returns the service version to export.
+func exportVersion(service *fastly.ServiceDetail, version int32) (int32, error) {
+	versions := service.GetVersions()
+	if len(versions) == 0 {
+		return 0, fmt.Errorf("failed to find any versions for service '%s'", service.GetID())
+	}
+
+	if version != 0 {
+		for _, v := range versions {
+			if v.GetNumber() == version {
+				return version, nil
+			}
+		}
+		return 0, fmt.Errorf("failed to find version '%d' for service '%s'", version, service.GetID())
+	}
+
+	for _, v := range versions {
+		if v.GetActive() {
+			return v.GetNumber(), nil
+		}
+	}
+	return versions[len(versions)-1].GetNumber(), nil
+}
+
+func serviceDetail(api helpers.API, serviceID string) (*fastly.ServiceDetail, error) {
+	clientResp, httpResp, err := api.Client.ServiceAPI.GetServiceDetail(api.ClientCtx, serviceID).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read service details: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if t, ok := clientResp.GetDeletedAtOk(); ok && t != nil {
+		return nil, fmt.Errorf("service '%s' has been deleted", serviceID)
+	}
+	return clientResp, nil
+}
+
+func serviceSettings(api helpers.API, serviceID string, version int32) (*fastly.SettingsResponse, error) {
+	clientResp, httpResp, err := api.Client.SettingsAPI.GetServiceSettings(api.ClientCtx, serviceID, version).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read service settings: %w", err)
+	}
+	defer httpResp.Body.Close()
+	return clientResp, nil
+}
+
+// serviceHTTP3 indicates if HTTP/3 is enabled for the service version.
+//
+// NOTE: The API returns a 404 if HTTP/3 isn't enabled.
+func serviceHTTP3(api helpers.API, serviceID string, version int32) (bool, error) {
+	_, httpResp, err := api.Client.HTTP3API.GetHTTP3(api.ClientCtx, serviceID, version).Execute()
+	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			return false, nil
+		}
+		return false, fmt.Errorf("unable to read HTTP/3 setting: %w", err)
+	}
+	defer httpResp.Body.Close()
+	return true, nil
+}
+
+// product is a product enablement of the service.
+type product struct {
+	// id is the product ID, which is also the name of the service attribute.
+	id string
+	// enabled indicates if the product is enabled.
+	enabled bool
+}
+
+// serviceProducts returns the product enablements of the service.
+func serviceProducts(api helpers.API, serviceID string) ([]product, error) {
+	products := []product{
+		{id: helpers.ProductBotManagement},
+		{id: helpers.ProductBrotliCompression},
+		{id: helpers.ProductDomainInspector},
+		{id: helpers.ProductImageOptimizer},
+		{id: helpers.ProductOriginInspector},
+		{id: helpers.ProductWebSockets},
+	}
+	for i := range products {
+		var diags diag.Diagnostics
+		enabled, err := helpers.ProductEnabled(context.Background(), api, products[i].id, serviceID, &diags)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read product '%s': %w", products[i].id, err)
+		}
+		products[i].enabled = enabled
+	}
+	return products, nil
+}
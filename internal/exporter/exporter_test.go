@@ -0,0 +1,176 @@
+package exporter
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/mockapi"
+)
+
+func TestServiceVCL(t *testing.T) {
+	_, api, serviceID := mockapi.NewService(t)
+
+	clientReq := api.Client.ServiceAPI.UpdateService(api.ClientCtx, serviceID)
+	clientReq.Name("My Service")
+	clientReq.Comment("hand built")
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		t.Fatalf("failed to update mock service: %s", err)
+	}
+	httpResp.Body.Close()
+
+	for _, name := range []string{"www.example.com", "www-example.com", "www_example.com", "*.example.com"} {
+		domainReq := api.Client.DomainAPI.CreateDomain(api.ClientCtx, serviceID, 1)
+		domainReq.Name(name)
+		_, httpResp, err := domainReq.Execute()
+		if err != nil {
+			t.Fatalf("failed to create mock domain: %s", err)
+		}
+		httpResp.Body.Close()
+	}
+
+	hcl, err := ServiceVCL(api, serviceID, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, want := range []string{
+		`resource "fastly_service_vcl" "my_service" {`,
+		`to = fastly_service_vcl.my_service`,
+		`id = "` + serviceID + `@1"`,
+		`name               = "My Service"`,
+		`comment            = "hand built"`,
+		`default_ttl        = 3600`,
+		`www-example_com = {`,
+		`www_example_com = {`,
+		`www_example_com_2 = {`,
+		`wildcard_example_com = {`,
+	} {
+		if !strings.Contains(string(hcl), want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, hcl)
+		}
+	}
+
+	if strings.Contains(string(hcl), "default_host") {
+		t.Errorf("expected unset default host to be omitted, got:\n%s", hcl)
+	}
+
+	if _, err := ServiceVCL(api, serviceID, 2); err == nil {
+		t.Error("expected error for missing version")
+	}
+}
+
+func TestName(t *testing.T) {
+	for input, want := range map[string]string{
+		"www.example.com":  "www_example_com",
+		"My Service":       "my_service",
+		"1.example.com":    "_1_example_com",
+		"-leading-hyphen":  "_-leading-hyphen",
+		"**":               "",
+		"already_an_ident": "already_an_ident",
+	} {
+		if got := Name(input); got != want {
+			t.Errorf("Name(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+// TestServiceVCLNestedResources validates the nested resources (and the other
+// service attributes) are exported, by parsing the exported configuration
+// back and comparing it with the service.
+func TestServiceVCLNestedResources(t *testing.T) {
+	_, api, serviceID := mockapi.NewService(t)
+
+	dictionaryReq := api.Client.DictionaryAPI.CreateDictionary(api.ClientCtx, serviceID, 1)
+	dictionaryReq.Name("redirects")
+	dictionaryReq.WriteOnly(true)
+	_, httpResp, err := dictionaryReq.Execute()
+	if err != nil {
+		t.Fatalf("failed to create mock dictionary: %s", err)
+	}
+	httpResp.Body.Close()
+
+	kafkaReq := api.Client.LoggingKafkaAPI.CreateLogKafka(api.ClientCtx, serviceID, 1)
+	kafkaReq.Name("kafka")
+	kafkaReq.Brokers("broker.example.com:9093")
+	kafkaReq.Topic("logs")
+	_, httpResp, err = kafkaReq.Execute()
+	if err != nil {
+		t.Fatalf("failed to create mock kafka endpoint: %s", err)
+	}
+	httpResp.Body.Close()
+
+	_, httpResp, err = api.Client.HTTP3API.CreateHTTP3(api.ClientCtx, serviceID, 1).Execute()
+	if err != nil {
+		t.Fatalf("failed to enable mock HTTP/3: %s", err)
+	}
+	httpResp.Body.Close()
+	var diags diag.Diagnostics
+	if err := helpers.EnableProduct(context.Background(), api, helpers.ProductWebSockets, serviceID, &diags); err != nil {
+		t.Fatalf("failed to enable mock product: %s (%v)", err, diags)
+	}
+
+	out, err := ServiceVCL(api, serviceID, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	f, parseDiags := hclsyntax.ParseConfig(out, "service.tf", hcl.InitialPos)
+	if parseDiags.HasErrors() {
+		t.Fatalf("failed to parse the exported configuration: %s\n%s", parseDiags, out)
+	}
+	var attrs hclsyntax.Attributes
+	for _, block := range f.Body.(*hclsyntax.Body).Blocks {
+		if block.Type == "resource" {
+			attrs = block.Body.Attributes
+		}
+	}
+	value := func(name string) cty.Value {
+		attr, ok := attrs[name]
+		if !ok {
+			t.Fatalf("expected the %s attribute to be exported, got:\n%s", name, out)
+		}
+		v, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() {
+			t.Fatalf("failed to evaluate the %s attribute: %s", name, diags)
+		}
+		return v
+	}
+
+	dictionary := value("dictionaries").GetAttr("redirects")
+	if name := dictionary.GetAttr("name"); name.AsString() != "redirects" {
+		t.Errorf("want dictionary name redirects, got: %s", name.GoString())
+	}
+	if writeOnly := dictionary.GetAttr("write_only"); !writeOnly.True() {
+		t.Errorf("want a write-only dictionary, got: %s", writeOnly.GoString())
+	}
+	if dictionary.Type().HasAttribute("dictionary_id") {
+		t.Errorf("expected the computed dictionary ID to be omitted, got:\n%s", out)
+	}
+
+	kafka := value("logging_kafka").GetAttr("kafka")
+	if brokers := kafka.GetAttr("brokers"); brokers.AsString() != "broker.example.com:9093" {
+		t.Errorf("want kafka brokers broker.example.com:9093, got: %s", brokers.GoString())
+	}
+
+	for name, want := range map[string]bool{
+		"activate":        false,
+		"http3":           true,
+		"image_optimizer": false,
+		"websockets":      true,
+	} {
+		if got := value(name); got.True() != want {
+			t.Errorf("want %s %t, got: %s", name, want, got.GoString())
+		}
+	}
+	if _, ok := attrs["logging_kinesis"]; ok {
+		t.Errorf("expected the unused logging_kinesis attribute to be omitted, got:\n%s", out)
+	}
+}
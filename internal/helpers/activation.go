@@ -0,0 +1,204 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// ActivationMaxRetries is the number of times a conflicting activation is
+// retried when no activation timeout has been configured.
+const ActivationMaxRetries = 5
+
+// ActivationRetryBackoff is the default delay before the first activation
+// retry. The delay is doubled for each subsequent retry (up to
+// activationMaxBackoff).
+//
+// NOTE: This is a variable so tests can reduce the delay.
+var ActivationRetryBackoff = 2 * time.Second
+
+// activationMaxBackoff is the maximum delay between activation retries.
+const activationMaxBackoff = 30 * time.Second
+
+// DeploymentPollInterval is the delay between checks of a deployment's status.
+//
+// NOTE: This is a variable so tests can reduce the delay.
+var DeploymentPollInterval = 5 * time.Second
+
+// defaultDeploymentTimeout is the maximum duration to wait for a deployment
+// when no deployment timeout has been configured.
+const defaultDeploymentTimeout = 10 * time.Minute
+
+// ActivationOptions control the retrying of a conflicting activation, and
+// waiting for the activated version to be deployed.
+type ActivationOptions struct {
+	// DeploymentTimeout is the maximum duration to wait for a deployment (defaults to defaultDeploymentTimeout).
+	DeploymentTimeout time.Duration
+	// Interval is the delay before the first retry (defaults to ActivationRetryBackoff).
+	Interval time.Duration
+	// Timeout is the maximum duration to retry for (defaults to ActivationMaxRetries retries).
+	Timeout time.Duration
+	// WaitForDeployment waits for the activated version to be deployed.
+	WaitForDeployment bool
+	// Window restricts when a version can be activated (see CheckActivationWindow).
+	Window *models.ActivationWindow
+	// WindowOverride allows a version to be activated outside of the window.
+	WindowOverride types.Bool
+}
+
+// ActivateService activates the service version and returns the version number.
+//
+// The API returns a 409 Conflict if another activation is already in flight
+// (e.g. a concurrent apply or a deploy via the Fastly UI). In that case we poll
+// the version status, as the conflicting activation might have been for the
+// same version, and otherwise retry the activation with an exponential backoff
+// until the activation timeout elapses (or ActivationMaxRetries is reached).
+//
+// If the `wait_for_deployment` attribute is enabled, then we also wait for the
+// activated version to be deployed (see WaitForDeployment).
+func ActivateService(
+	ctx context.Context,
+	serviceID string,
+	serviceVersion int32,
+	opts ActivationOptions,
+	api API,
+	diags *diag.Diagnostics,
+) (int64, error) {
+	waiter := Waiter{
+		Interval:    ActivationRetryBackoff,
+		MaxAttempts: ActivationMaxRetries + 1,
+		MaxInterval: activationMaxBackoff,
+		Multiplier:  2,
+	}
+	if opts.Interval > 0 {
+		waiter.Interval = opts.Interval
+	}
+	if opts.Timeout > 0 {
+		waiter.MaxAttempts = 0
+		waiter.Timeout = opts.Timeout
+	}
+
+	var (
+		activatedVersion int64
+		httpResp         *http.Response
+		err              error
+	)
+	waitErr := waiter.Wait(api.ClientCtx, func(attempt int) (bool, error) {
+		var clientResp *fastly.VersionResponse
+		clientReq := api.Client.VersionAPI.ActivateServiceVersion(api.ClientCtx, serviceID, serviceVersion)
+		clientResp, httpResp, err = clientReq.Execute()
+		if err == nil {
+			httpResp.Body.Close()
+			activatedVersion = int64(clientResp.GetNumber())
+			return true, nil
+		}
+		if httpResp == nil || httpResp.StatusCode != http.StatusConflict {
+			return false, err
+		}
+		httpResp.Body.Close()
+
+		if isActiveVersion(ctx, api, serviceID, serviceVersion) {
+			tflog.Debug(ctx, "Service version activated by a conflicting activation", map[string]any{"version": serviceVersion})
+			activatedVersion = int64(serviceVersion)
+			return true, nil
+		}
+
+		tflog.Debug(ctx, "Service version activation conflict, retrying", map[string]any{
+			"attempt": attempt,
+			"backoff": waiter.Delay(attempt).String(),
+			"version": serviceVersion,
+		})
+		return false, nil
+	})
+	if waitErr != nil {
+		// NOTE: The API error is more useful than a timeout (unless the context
+		// was cancelled while waiting to retry).
+		if errors.Is(waitErr, ErrWaitTimeout) {
+			waitErr = err
+		}
+		tflog.Trace(ctx, "Fastly VersionAPI.ActivateServiceVersion error", map[string]any{"http_resp": LogResponse(httpResp)})
+		APIError(httpResp, waitErr, diags, fmt.Sprintf("Unable to activate service version %d", serviceVersion))
+		return 0, waitErr
+	}
+
+	if opts.WaitForDeployment {
+		if err := WaitForDeployment(ctx, serviceID, serviceVersion, opts, api, diags); err != nil {
+			return 0, err
+		}
+	}
+
+	return activatedVersion, nil
+}
+
+// WaitForDeployment polls the activated service version until the deployment
+// is complete, i.e. the version is reported as active and its generated VCL is
+// available, or until the deployment timeout elapses.
+//
+// NOTE: The API doesn't report the propagation of a version to each POP. But
+// the generated VCL is only available once the version has been compiled.
+func WaitForDeployment(
+	ctx context.Context,
+	serviceID string,
+	serviceVersion int32,
+	opts ActivationOptions,
+	api API,
+	diags *diag.Diagnostics,
+) error {
+	waiter := Waiter{
+		Interval: DeploymentPollInterval,
+		Timeout:  defaultDeploymentTimeout,
+	}
+	if opts.DeploymentTimeout > 0 {
+		waiter.Timeout = opts.DeploymentTimeout
+	}
+
+	err := waiter.Wait(api.ClientCtx, func(attempt int) (bool, error) {
+		if !isActiveVersion(ctx, api, serviceID, serviceVersion) {
+			tflog.Debug(ctx, "Service version not yet active, waiting for deployment", map[string]any{"attempt": attempt, "version": serviceVersion})
+			return false, nil
+		}
+
+		clientReq := api.Client.VclAPI.GetCustomVclGenerated(api.ClientCtx, serviceID, serviceVersion)
+		clientResp, httpResp, err := clientReq.Execute()
+		if err != nil {
+			if IsNotFound(httpResp) {
+				tflog.Debug(ctx, "Generated VCL not yet available, waiting for deployment", map[string]any{"attempt": attempt, "version": serviceVersion})
+				return false, nil
+			}
+			tflog.Trace(ctx, "Fastly VclAPI.GetCustomVclGenerated error", map[string]any{"http_resp": LogResponse(httpResp)})
+			return false, err
+		}
+		httpResp.Body.Close()
+
+		return clientResp.GetContent() != "", nil
+	})
+	if err != nil {
+		diags.AddError(ErrorAPIClient, fmt.Sprintf("Service version %d was activated but its deployment didn't complete, got error: %s", serviceVersion, err))
+		return err
+	}
+
+	tflog.Debug(ctx, "Service version deployed", map[string]any{"version": serviceVersion})
+	return nil
+}
+
+// isActiveVersion indicates if the service version is currently active.
+func isActiveVersion(ctx context.Context, api API, serviceID string, serviceVersion int32) bool {
+	clientReq := api.Client.VersionAPI.GetServiceVersion(api.ClientCtx, serviceID, serviceVersion)
+	clientResp, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly VersionAPI.GetServiceVersion error", map[string]any{"http_resp": LogResponse(httpResp)})
+		return false
+	}
+	defer httpResp.Body.Close()
+
+	return clientResp.GetActive()
+}
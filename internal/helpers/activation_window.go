@@ -0,0 +1,162 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// NOTE: The time zone database is embedded, as the provider can run on a
+	// system without one (e.g. Windows or a minimal container image).
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// weekdays maps the abbreviated day names accepted by an activation window.
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// ActivationWindow is a recurring period of time (e.g. 09:00-17:00 on
+// weekdays) during which a service version may be activated.
+//
+// NOTE: A window whose end is before its start spans midnight (e.g.
+// 22:00-02:00), and a window whose start and end are equal lasts a whole day.
+type ActivationWindow struct {
+	// Days are the days of the week the window opens on (every day if empty).
+	Days []time.Weekday
+	// End is the time of day (as an offset from midnight) the window closes.
+	End time.Duration
+	// Location is the time zone of the window.
+	Location *time.Location
+	// Start is the time of day (as an offset from midnight) the window opens.
+	Start time.Duration
+
+	// text describes the window as configured.
+	text string
+}
+
+// ParseActivationWindow returns the activation window described by the
+// `activation_window` attribute values.
+func ParseActivationWindow(days []types.String, start, end, timeZone types.String) (*ActivationWindow, error) {
+	w := &ActivationWindow{Location: time.UTC}
+
+	var err error
+	if w.Start, err = ParseTimeOfDay(start.ValueString()); err != nil {
+		return nil, err
+	}
+	if w.End, err = ParseTimeOfDay(end.ValueString()); err != nil {
+		return nil, err
+	}
+	if !timeZone.IsNull() {
+		if w.Location, err = time.LoadLocation(timeZone.ValueString()); err != nil {
+			return nil, fmt.Errorf("invalid time zone '%s': %w", timeZone.ValueString(), err)
+		}
+	}
+
+	names := make([]string, 0, len(days))
+	for _, day := range days {
+		weekday, ok := weekdays[strings.ToLower(day.ValueString())]
+		if !ok {
+			return nil, fmt.Errorf("invalid day '%s'", day.ValueString())
+		}
+		w.Days = append(w.Days, weekday)
+		names = append(names, strings.ToLower(day.ValueString()))
+	}
+
+	w.text = fmt.Sprintf("%s-%s %s", start.ValueString(), end.ValueString(), w.Location)
+	if len(names) > 0 {
+		w.text += " on " + strings.Join(names, ", ")
+	}
+
+	return w, nil
+}
+
+// ParseTimeOfDay parses a 24-hour time of day (e.g. `17:30`) and returns it as
+// an offset from midnight.
+func ParseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day '%s' (expected HH:MM)", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Contains reports whether the window is open at the given time.
+func (w *ActivationWindow) Contains(t time.Time) bool {
+	t = t.In(w.Location)
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+
+	if w.Start < w.End {
+		return offset >= w.Start && offset < w.End && w.opensOn(t.Weekday())
+	}
+
+	// The window spans midnight, so before the end it opened the previous day.
+	if offset >= w.Start {
+		return w.opensOn(t.Weekday())
+	}
+	if offset < w.End {
+		return w.opensOn((t.Weekday() + 6) % 7)
+	}
+	return false
+}
+
+// String describes the window (e.g. `09:00-17:00 Europe/London on mon, fri`).
+func (w *ActivationWindow) String() string {
+	return w.text
+}
+
+// opensOn reports whether the window opens on the given day.
+func (w *ActivationWindow) opensOn(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, d := range w.Days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckActivationWindow returns an error (and adds it to the diagnostics) if a
+// service version would be activated outside of the `activation_window`,
+// unless `activation_window_override` is set. A nil window is always open.
+func CheckActivationWindow(config *models.ActivationWindow, override types.Bool, now time.Time, diags *diag.Diagnostics) error {
+	if config == nil {
+		return nil
+	}
+
+	window, err := ParseActivationWindow(config.Days, config.Start, config.End, config.TimeZone)
+	if err != nil {
+		diags.AddAttributeError(path.Root("activation_window"), "Invalid Activation Window", err.Error())
+		return err
+	}
+
+	if window.Contains(now) {
+		return nil
+	}
+	if override.ValueBool() {
+		diags.AddWarning(
+			"Activation Window Overridden",
+			fmt.Sprintf("The service version is being activated outside of the activation window (%s) as `activation_window_override` is set.", window),
+		)
+		return nil
+	}
+
+	diags.AddError(
+		ErrorUser,
+		fmt.Sprintf("The service version can't be activated outside of the activation window (%s). Apply again once the window is open, or set `activation_window_override` to `true` to activate it anyway.", window),
+	)
+	return fmt.Errorf("outside of the activation window (%s)", window)
+}
@@ -0,0 +1,93 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+func TestActivationWindowContains(t *testing.T) {
+	weekdays := []types.String{
+		types.StringValue("mon"),
+		types.StringValue("tue"),
+		types.StringValue("wed"),
+		types.StringValue("thu"),
+		types.StringValue("fri"),
+	}
+
+	office, err := ParseActivationWindow(weekdays, types.StringValue("09:00"), types.StringValue("17:00"), types.StringValue("Europe/London"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	overnight, err := ParseActivationWindow([]types.String{types.StringValue("fri")}, types.StringValue("22:00"), types.StringValue("02:00"), types.StringNull())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, tc := range []struct {
+		window *ActivationWindow
+		time   string
+		want   bool
+	}{
+		// 2026-10-14 is a Wednesday (and London is on BST, i.e. UTC+1).
+		{office, "2026-10-14T08:30:00Z", true},
+		{office, "2026-10-14T07:59:59Z", false},
+		{office, "2026-10-14T16:00:00Z", false},
+		{office, "2026-10-17T10:00:00Z", false},
+		{overnight, "2026-10-16T23:00:00Z", true},
+		{overnight, "2026-10-17T01:59:00Z", true},
+		{overnight, "2026-10-17T02:00:00Z", false},
+		{overnight, "2026-10-15T23:00:00Z", false},
+	} {
+		now, _ := time.Parse(time.RFC3339, tc.time)
+		if got := tc.window.Contains(now); got != tc.want {
+			t.Errorf("%s: Contains(%s) = %t, want %t", tc.window, tc.time, got, tc.want)
+		}
+	}
+
+	if want := "09:00-17:00 Europe/London on mon, tue, wed, thu, fri"; office.String() != want {
+		t.Errorf("String() = %q, want %q", office, want)
+	}
+}
+
+func TestParseActivationWindowInvalid(t *testing.T) {
+	for name, tc := range map[string]struct {
+		days                 []types.String
+		start, end, timeZone types.String
+	}{
+		"time of day": {nil, types.StringValue("9am"), types.StringValue("17:00"), types.StringNull()},
+		"time zone":   {nil, types.StringValue("09:00"), types.StringValue("17:00"), types.StringValue("Mars/Olympus")},
+		"day":         {[]types.String{types.StringValue("someday")}, types.StringValue("09:00"), types.StringValue("17:00"), types.StringNull()},
+	} {
+		if _, err := ParseActivationWindow(tc.days, tc.start, tc.end, tc.timeZone); err == nil {
+			t.Errorf("expected an error for an invalid %s", name)
+		}
+	}
+}
+
+func TestCheckActivationWindow(t *testing.T) {
+	config := &models.ActivationWindow{
+		End:      types.StringValue("17:00"),
+		Start:    types.StringValue("09:00"),
+		TimeZone: types.StringNull(),
+	}
+	closed := time.Date(2026, 10, 14, 20, 0, 0, 0, time.UTC)
+
+	var diags diag.Diagnostics
+	if err := CheckActivationWindow(nil, types.BoolValue(false), closed, &diags); err != nil || diags.HasError() {
+		t.Errorf("expected no window to always be open, got: %v", err)
+	}
+
+	if err := CheckActivationWindow(config, types.BoolValue(false), closed, &diags); err == nil || !diags.HasError() {
+		t.Error("expected an error outside of the window")
+	}
+
+	diags = nil
+	if err := CheckActivationWindow(config, types.BoolValue(true), closed, &diags); err != nil || diags.WarningsCount() != 1 {
+		t.Errorf("expected an override to warn rather than fail, got: %v (%v)", err, diags)
+	}
+}
@@ -7,6 +7,11 @@ import (
 )
 
 // API is a simple helper for avoiding passing large service model data structure.
+//
+// NOTE: Each API exposed by the fastly.APIClient (e.g. ServiceAPI, DomainAPI)
+// is an interface, with the generated API services as the default
+// implementation. Unit tests can therefore construct a fastly.APIClient with
+// fake implementations of only the APIs they need, without live credentials.
 type API struct {
 	Client    *fastly.APIClient
 	ClientCtx context.Context
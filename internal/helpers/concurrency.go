@@ -0,0 +1,54 @@
+package helpers
+
+import (
+	"sync"
+)
+
+// MaxConcurrency is the maximum number of concurrent Fastly API calls the
+// provider will make when processing nested resources.
+//
+// NOTE: The Fastly API applies rate limits.
+// So we avoid an unbounded number of goroutines for large services.
+const MaxConcurrency = 8
+
+// ForEach calls fn for every index in the range [0, n) using no more than
+// limit goroutines. It waits for all calls to complete and returns the first
+// error encountered (if any).
+//
+// NOTE: fn must be safe for concurrent use.
+// e.g. it must not append to a shared diag.Diagnostics without synchronisation.
+func ForEach(n, limit int, fn func(i int) error) error {
+	if limit < 1 {
+		limit = 1
+	}
+
+	var (
+		firstErr error
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+	)
+
+	sem := make(chan struct{}, limit)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := fn(i); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	return firstErr
+}
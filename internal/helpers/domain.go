@@ -0,0 +1,30 @@
+package helpers
+
+import (
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+// IsApexDomain indicates if the domain is an apex (or 'naked') domain, i.e. it
+// is only one label more than its public suffix (e.g. example.com or
+// example.co.uk but not www.example.com).
+//
+// NOTE: Apex domains can't be pointed at Fastly using a CNAME record.
+// A wildcard domain is never an apex domain.
+func IsApexDomain(name string) bool {
+	if IsWildcardDomain(name) {
+		return false
+	}
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	apex, err := publicsuffix.EffectiveTLDPlusOne(name)
+	if err != nil {
+		return false
+	}
+	return apex == name
+}
+
+// IsWildcardDomain indicates if the domain is a wildcard (e.g. *.example.com).
+func IsWildcardDomain(name string) bool {
+	return strings.HasPrefix(name, "*.")
+}
@@ -0,0 +1,133 @@
+package helpers
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DomainMoveTimeout is the maximum duration a domain creation waits for the
+// domain to be released by another service in the same apply (see
+// DomainReleaseExpected and WaitForDomainRelease).
+var DomainMoveTimeout = 5 * time.Minute
+
+// domainReleaseBackoff is the delay before the first retry of a domain
+// creation. The delay is doubled for each subsequent retry.
+var domainReleaseBackoff = 2 * time.Second
+
+// domainReleaseMaxBackoff is the maximum delay between retries.
+const domainReleaseMaxBackoff = 30 * time.Second
+
+// domainReleases notifies anything waiting on a domain that it's been released.
+//
+// NOTE: Terraform applies all resources using a single provider process. So
+// when a domain is moved between two services in the same apply, the service
+// deleting the domain can notify the service creating it (which would
+// otherwise fail because the domain is still associated with another service).
+var domainReleases = struct {
+	mu sync.Mutex
+	// expected are the domains that are being (or have been) released by a
+	// service in this apply.
+	expected map[string]bool
+	waiters  map[string][]chan struct{}
+}{
+	expected: make(map[string]bool),
+	waiters:  make(map[string][]chan struct{}),
+}
+
+// ExpectDomainRelease records that the domain is going to be released by a
+// service in this apply (see ReleaseDomain), so a service creating the domain
+// waits for it rather than failing.
+//
+// NOTE: It should be called before any API call is made, as the services are
+// applied concurrently.
+func ExpectDomainRelease(name string) {
+	name = strings.ToLower(name)
+
+	domainReleases.mu.Lock()
+	defer domainReleases.mu.Unlock()
+
+	domainReleases.expected[name] = true
+}
+
+// DomainReleaseExpected reports whether the domain is being (or has been)
+// released by a service in this apply (see ExpectDomainRelease).
+func DomainReleaseExpected(name string) bool {
+	name = strings.ToLower(name)
+
+	domainReleases.mu.Lock()
+	defer domainReleases.mu.Unlock()
+
+	return domainReleases.expected[name]
+}
+
+// ReleaseDomain notifies anything waiting for the domain to be released from
+// a service (i.e. the domain has been deleted from the active service version,
+// or the service has been deleted).
+func ReleaseDomain(name string) {
+	name = strings.ToLower(name)
+
+	domainReleases.mu.Lock()
+	defer domainReleases.mu.Unlock()
+
+	domainReleases.expected[name] = true
+	for _, ch := range domainReleases.waiters[name] {
+		close(ch)
+	}
+	delete(domainReleases.waiters, name)
+}
+
+// WaitForDomainRelease blocks until the domain is released (see ReleaseDomain),
+// the delay has elapsed or the context is done. It returns false only if the
+// context is done.
+func WaitForDomainRelease(ctx context.Context, name string, delay time.Duration) bool {
+	name = strings.ToLower(name)
+	ch := make(chan struct{})
+
+	domainReleases.mu.Lock()
+	domainReleases.waiters[name] = append(domainReleases.waiters[name], ch)
+	domainReleases.mu.Unlock()
+
+	defer func() {
+		domainReleases.mu.Lock()
+		defer domainReleases.mu.Unlock()
+		waiters := domainReleases.waiters[name]
+		for i, w := range waiters {
+			if w == ch {
+				domainReleases.waiters[name] = append(waiters[:i], waiters[i+1:]...)
+				break
+			}
+		}
+		if len(domainReleases.waiters[name]) == 0 {
+			delete(domainReleases.waiters, name)
+		}
+	}()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ch:
+		return true
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// DomainReleaseWaiter returns a Waiter for retrying the creation of a domain
+// that's associated with another service, until the domain is released (see
+// WaitForDomainRelease) or DomainMoveTimeout elapses.
+func DomainReleaseWaiter(name string) Waiter {
+	return Waiter{
+		Interval:    domainReleaseBackoff,
+		MaxInterval: domainReleaseMaxBackoff,
+		Multiplier:  2,
+		Sleep: func(ctx context.Context, delay time.Duration) bool {
+			return WaitForDomainRelease(ctx, name, delay)
+		},
+		Timeout: DomainMoveTimeout,
+	}
+}
@@ -0,0 +1,65 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWaitForDomainRelease(t *testing.T) {
+	done := make(chan bool)
+	go func() {
+		done <- WaitForDomainRelease(context.Background(), "example.com", time.Hour)
+	}()
+
+	for {
+		select {
+		case ok := <-done:
+			if !ok {
+				t.Error("expected the wait to end because the domain was released")
+			}
+			return
+		case <-time.After(10 * time.Millisecond):
+			ReleaseDomain("EXAMPLE.com")
+		}
+	}
+}
+
+func TestWaitForDomainReleaseCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if WaitForDomainRelease(ctx, "example.com", time.Hour) {
+		t.Error("expected the wait to end because the context was cancelled")
+	}
+}
+
+func TestDomainReleaseWaiterDelay(t *testing.T) {
+	waiter := DomainReleaseWaiter("example.com")
+
+	for attempt, want := range map[int]time.Duration{
+		1:  domainReleaseBackoff,
+		2:  2 * domainReleaseBackoff,
+		10: domainReleaseMaxBackoff,
+	} {
+		if got := waiter.Delay(attempt); got != want {
+			t.Errorf("Delay(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+}
+
+func TestDomainReleaseExpected(t *testing.T) {
+	if DomainReleaseExpected("expected.example.com") {
+		t.Fatal("expected no release before the domain is registered")
+	}
+
+	ExpectDomainRelease("Expected.example.com")
+	if !DomainReleaseExpected("expected.example.com") {
+		t.Error("expected the registered release")
+	}
+
+	ReleaseDomain("released.example.com")
+	if !DomainReleaseExpected("released.example.com") {
+		t.Error("expected a released domain to be registered")
+	}
+}
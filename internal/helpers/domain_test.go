@@ -0,0 +1,29 @@
+package helpers
+
+import "testing"
+
+func TestIsApexDomain(t *testing.T) {
+	for name, want := range map[string]bool{
+		"example.com":       true,
+		"example.co.uk":     true,
+		"Example.COM.":      true,
+		"www.example.com":   false,
+		"a.b.example.com":   false,
+		"com":               false,
+		"www.example.co.uk": false,
+		"*.example.com":     false,
+	} {
+		if got := IsApexDomain(name); got != want {
+			t.Errorf("IsApexDomain(%q) = %t, want %t", name, got, want)
+		}
+	}
+}
+
+func TestIsWildcardDomain(t *testing.T) {
+	if !IsWildcardDomain("*.example.com") {
+		t.Error("expected *.example.com to be a wildcard domain")
+	}
+	if IsWildcardDomain("www.example.com") {
+		t.Error("expected www.example.com not to be a wildcard domain")
+	}
+}
@@ -14,3 +14,6 @@ const (
 	// ErrorUser indicates a User error.
 	ErrorUser = "User Error"
 )
+
+// WarningServiceDeleted indicates the service was deleted outside of Terraform.
+const WarningServiceDeleted = "Service Deleted Outside Of Terraform"
@@ -0,0 +1,96 @@
+package helpers
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// tlsVersions are the supported minimum TLS versions, keyed by their name.
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// HTTPTransportOptions configures the connection pooling behaviour of the
+// transport used by the Fastly API client.
+//
+// A zero value for any field leaves the http.DefaultTransport setting as-is.
+type HTTPTransportOptions struct {
+	// DisableHTTP2 prevents the transport from negotiating HTTP/2.
+	DisableHTTP2 bool
+	// DisableKeepAlives disables connection reuse.
+	DisableKeepAlives bool
+	// IdleConnTimeout is how long an idle connection remains in the pool.
+	IdleConnTimeout time.Duration
+	// MaxIdleConns is the maximum number of idle connections across all hosts.
+	MaxIdleConns int
+	// MaxIdleConnsPerHost is the maximum number of idle connections per host.
+	MaxIdleConnsPerHost int
+	// MinTLSVersion is the minimum TLS version (e.g. tls.VersionTLS13).
+	MinTLSVersion uint16
+	// RootCAs are the certificate authorities trusted to verify the API (or a
+	// TLS-intercepting proxy) certificate. The system pool is used if nil.
+	RootCAs *x509.CertPool
+}
+
+// NewHTTPTransport returns a copy of http.DefaultTransport configured with the
+// given options. The default proxy behaviour (i.e. HTTP_PROXY, HTTPS_PROXY and
+// NO_PROXY environment variables) is preserved.
+func NewHTTPTransport(opts HTTPTransportOptions) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	transport.DisableKeepAlives = opts.DisableKeepAlives
+
+	if opts.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = opts.IdleConnTimeout
+	}
+	if opts.MaxIdleConns > 0 {
+		transport.MaxIdleConns = opts.MaxIdleConns
+	}
+	if opts.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = opts.MaxIdleConnsPerHost
+	}
+
+	// NOTE: A non-nil empty TLSNextProto map disables HTTP/2.
+	// https://pkg.go.dev/net/http#hdr-HTTP_2
+	if opts.DisableHTTP2 {
+		transport.ForceAttemptHTTP2 = false
+		transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	}
+
+	if opts.MinTLSVersion != 0 || opts.RootCAs != nil {
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion: opts.MinTLSVersion,
+			RootCAs:    opts.RootCAs,
+		}
+	}
+
+	return transport
+}
+
+// CertPool returns the system certificate pool with the PEM encoded
+// certificates appended, so a TLS-intercepting proxy's CA can be trusted in
+// addition to the public CAs.
+func CertPool(pem string) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM([]byte(pem)) {
+		return nil, errors.New("no PEM encoded certificates were found")
+	}
+	return pool, nil
+}
+
+// TLSVersion returns the TLS version with the given name (e.g. `1.2`).
+func TLSVersion(name string) (uint16, error) {
+	v, ok := tlsVersions[name]
+	if !ok {
+		return 0, fmt.Errorf("unsupported TLS version '%s'", name)
+	}
+	return v, nil
+}
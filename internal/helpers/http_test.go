@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestNewHTTPTransportTLS validates a server certificate signed by a CA from
+// the bundle is trusted, and the minimum TLS version is enforced.
+func TestNewHTTPTransportTLS(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	server.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+
+	bundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	pool, err := CertPool(bundle)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := &http.Client{Transport: NewHTTPTransport(HTTPTransportOptions{RootCAs: pool})}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the bundled CA to be trusted, got error: %s", err)
+	}
+	resp.Body.Close()
+
+	client = &http.Client{Transport: NewHTTPTransport(HTTPTransportOptions{})}
+	if _, err := client.Get(server.URL); err == nil {
+		t.Error("expected an untrusted certificate to be rejected")
+	}
+
+	client = &http.Client{Transport: NewHTTPTransport(HTTPTransportOptions{MinTLSVersion: tls.VersionTLS13, RootCAs: pool})}
+	if _, err := client.Get(server.URL); err == nil {
+		t.Error("expected a TLS 1.2 server to be rejected when TLS 1.3 is required")
+	}
+
+	if _, err := CertPool("not a certificate"); err == nil {
+		t.Error("expected error for a bundle without certificates")
+	}
+	if _, err := TLSVersion("1.1"); err == nil {
+		t.Error("expected error for an unsupported TLS version")
+	}
+}
@@ -0,0 +1,107 @@
+package helpers
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RedactedValue replaces a secret in the provider logs.
+const RedactedValue = "(sensitive value)"
+
+// sensitiveHeaders are the HTTP headers that carry credentials.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Fastly-Key", "Set-Cookie"}
+
+// LogResponse returns the loggable details of an API response.
+//
+// The response is logged as a summary (rather than the *http.Response itself)
+// because the response references the request, whose headers contain the
+// user's API token.
+func LogResponse(httpResp *http.Response) map[string]any {
+	if httpResp == nil {
+		return nil
+	}
+
+	data := map[string]any{
+		"header": redactHeader(httpResp.Header),
+		"status": httpResp.Status,
+	}
+	if req := httpResp.Request; req != nil {
+		data["request_header"] = redactHeader(req.Header)
+		data["request_method"] = req.Method
+		if req.URL != nil {
+			data["request_url"] = req.URL.String()
+		}
+	}
+	return data
+}
+
+// redactHeader returns a copy of the header with any credentials replaced.
+func redactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, name := range sensitiveHeaders {
+		if redacted.Get(name) != "" {
+			redacted.Set(name, RedactedValue)
+		}
+	}
+	return redacted
+}
+
+// LogState returns the Go-syntax representation of a resource data model for
+// logging, with the value of any string field tagged `sensitive:"true"`
+// replaced (the tag should accompany `Sensitive: true` in the schema).
+//
+// NOTE: The nested models (e.g. the values of a nested map attribute, such
+// as a logging endpoint) are also redacted.
+func LogState(model any) string {
+	rv := reflect.ValueOf(model)
+	if rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return fmt.Sprintf("%#v", model)
+	}
+	return fmt.Sprintf("%#v", redact(rv).Interface())
+}
+
+// redact returns a copy of the value with any sensitive string fields (of the
+// value or of its nested structs, pointers to structs and maps) replaced.
+func redact(rv reflect.Value) reflect.Value {
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+			return rv
+		}
+		redacted := reflect.New(rv.Elem().Type())
+		redacted.Elem().Set(redact(rv.Elem()))
+		return redacted
+	case reflect.Map:
+		if rv.IsNil() {
+			return rv
+		}
+		redacted := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			redacted.SetMapIndex(iter.Key(), redact(iter.Value()))
+		}
+		return redacted
+	case reflect.Struct:
+		redacted := reflect.New(rv.Type()).Elem()
+		redacted.Set(rv)
+		for i := 0; i < rv.NumField(); i++ {
+			field := rv.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if field.Tag.Get("sensitive") != "true" {
+				redacted.Field(i).Set(redact(rv.Field(i)))
+				continue
+			}
+			if v, ok := rv.Field(i).Interface().(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+				redacted.Field(i).Set(reflect.ValueOf(types.StringValue(RedactedValue)))
+			}
+		}
+		return redacted
+	default:
+		return rv
+	}
+}
@@ -0,0 +1,101 @@
+package helpers
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLogResponse(t *testing.T) {
+	if LogResponse(nil) != nil {
+		t.Error("expected nil response to be logged as nil")
+	}
+
+	req := &http.Request{
+		Header: http.Header{"Fastly-Key": {"secret-token"}, "Accept": {"application/json"}},
+		Method: http.MethodGet,
+		URL:    &url.URL{Scheme: "https", Host: "api.fastly.com", Path: "/service"},
+	}
+	httpResp := &http.Response{
+		Header:  http.Header{"Content-Type": {"application/json"}},
+		Request: req,
+		Status:  "200 OK",
+	}
+
+	data := LogResponse(httpResp)
+	header, _ := data["request_header"].(http.Header)
+	if got := header.Get("Fastly-Key"); got != RedactedValue {
+		t.Errorf("expected the API token to be redacted, got: %q", got)
+	}
+	if got := header.Get("Accept"); got != "application/json" {
+		t.Errorf("expected other headers to be logged, got: %q", got)
+	}
+	if got := req.Header.Get("Fastly-Key"); got != "secret-token" {
+		t.Errorf("expected the request header to be unchanged, got: %q", got)
+	}
+	if got := data["request_url"]; got != "https://api.fastly.com/service" {
+		t.Errorf("unexpected request URL: %v", got)
+	}
+}
+
+func TestLogState(t *testing.T) {
+	type model struct {
+		Name   types.String `tfsdk:"name"`
+		Secret types.String `tfsdk:"secret" sensitive:"true"`
+		Unset  types.String `tfsdk:"unset" sensitive:"true"`
+	}
+
+	data := &model{
+		Name:   types.StringValue("example"),
+		Secret: types.StringValue("hunter2"),
+		Unset:  types.StringNull(),
+	}
+
+	got := LogState(data)
+	if strings.Contains(got, "hunter2") {
+		t.Errorf("expected the secret to be redacted, got: %s", got)
+	}
+	for _, want := range []string{"example", RedactedValue} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q to be logged, got: %s", want, got)
+		}
+	}
+	if data.Secret.ValueString() != "hunter2" {
+		t.Error("expected the model to be unchanged")
+	}
+	if got := LogState((*model)(nil)); got != "(*helpers.model)(nil)" {
+		t.Errorf("unexpected nil model output: %s", got)
+	}
+}
+
+func TestLogStateNested(t *testing.T) {
+	type endpoint struct {
+		Name     types.String `tfsdk:"name"`
+		Password types.String `tfsdk:"password" sensitive:"true"`
+	}
+	type model struct {
+		Endpoints map[string]endpoint `tfsdk:"endpoints"`
+		Single    *endpoint           `tfsdk:"single"`
+	}
+
+	data := model{
+		Endpoints: map[string]endpoint{
+			"a": {Name: types.StringValue("example"), Password: types.StringValue("hunter2")},
+		},
+		Single: &endpoint{Name: types.StringValue("single"), Password: types.StringValue("hunter3")},
+	}
+
+	got := LogState(data)
+	if strings.Contains(got, "hunter2") || strings.Contains(got, "hunter3") {
+		t.Errorf("expected the nested secrets to be redacted, got: %s", got)
+	}
+	if !strings.Contains(got, "example") {
+		t.Errorf("expected the nested name to be logged, got: %s", got)
+	}
+	if data.Endpoints["a"].Password.ValueString() != "hunter2" || data.Single.Password.ValueString() != "hunter3" {
+		t.Error("expected the model to be unchanged")
+	}
+}
@@ -0,0 +1,119 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/fastly/fastly-go/fastly"
+)
+
+// Middleware wraps a http.RoundTripper with additional behaviour (e.g. retries,
+// auditing or setting custom headers).
+type Middleware func(http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc is an adapter to allow the use of ordinary functions as a
+// http.RoundTripper (for implementing Middleware).
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements the http.RoundTripper interface.
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Chain wraps transport with the given middleware.
+//
+// NOTE: The first middleware is the outermost, and so sees each request first.
+func Chain(transport http.RoundTripper, middleware ...Middleware) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	for i := len(middleware) - 1; i >= 0; i-- {
+		transport = middleware[i](transport)
+	}
+	return transport
+}
+
+// HeaderMiddleware returns Middleware that sets the header on every request.
+//
+// The value function is called for each request, which allows for the value to
+// change over time (e.g. a rotated Fastly-Key token). The header isn't set if
+// the function returns an empty string.
+func HeaderMiddleware(name string, value func() string) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			v := value()
+			if v == "" {
+				return next.RoundTrip(req)
+			}
+			req = req.Clone(req.Context())
+			req.Header.Set(name, v)
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// BodyMiddleware returns Middleware that replaces the body of every request with
+// the reader returned by the open function (e.g. an *os.File), so a large
+// payload is streamed rather than buffered in memory by the API client.
+//
+// The open function is also used to rewind the body when a request is retried.
+// The size is the length of the body in bytes.
+func BodyMiddleware(open func() (io.ReadCloser, error), size int64) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			body, err := open()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+			req.ContentLength = size
+			req.GetBody = open
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// ErrValidateOnly is returned for an API call that would make a change while the
+// provider's `validate_only` mode is enabled.
+var ErrValidateOnly = errors.New("the provider's validate_only mode is enabled, so no changes are made")
+
+// ValidateOnlyMiddleware rejects every request that could make a change (i.e.
+// any method other than GET, HEAD and OPTIONS) with ErrValidateOnly, so the
+// provider can read and validate a configuration without modifying anything.
+func ValidateOnlyMiddleware(next http.RoundTripper) http.RoundTripper {
+	return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return next.RoundTrip(req)
+		}
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, fmt.Errorf("%w (refused %s %s)", ErrValidateOnly, req.Method, req.URL.Path)
+	})
+}
+
+// WithMiddleware returns a copy of the API whose client sends all requests
+// through the given middleware (in addition to the client's own transport).
+func (a API) WithMiddleware(middleware ...Middleware) API {
+	if len(middleware) == 0 {
+		return a
+	}
+
+	cfg := *a.Client.GetConfig()
+	httpClient := http.DefaultClient
+	if cfg.HTTPClient != nil {
+		httpClient = cfg.HTTPClient
+	}
+	c := *httpClient
+	c.Transport = Chain(httpClient.Transport, middleware...)
+	cfg.HTTPClient = &c
+
+	return API{
+		Client:    fastly.NewAPIClient(&cfg),
+		ClientCtx: a.ClientCtx,
+	}
+}
@@ -0,0 +1,127 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fastly/fastly-go/fastly"
+)
+
+func TestChain(t *testing.T) {
+	var order []string
+	record := func(name string) Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: Chain(nil, record("first"), record("second"))}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if got := strings.Join(order, ","); got != "first,second" {
+		t.Errorf("expected middleware to be called in order, got %s", got)
+	}
+}
+
+func TestAPIWithMiddleware(t *testing.T) {
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Fastly-Key"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	cfg := fastly.NewConfiguration()
+	cfg.Servers = fastly.ServerConfigurations{{URL: server.URL}}
+	for op := range cfg.OperationServers {
+		cfg.OperationServers[op] = fastly.ServerConfigurations{{URL: server.URL}}
+	}
+	api := API{
+		Client:    fastly.NewAPIClient(cfg),
+		ClientCtx: context.WithValue(context.Background(), fastly.ContextAPIKeys, map[string]fastly.APIKey{"token": {Key: "original"}}),
+	}
+
+	token := "rotated"
+	wrapped := api.WithMiddleware(HeaderMiddleware("Fastly-Key", func() string { return token }))
+
+	for _, a := range []API{api, wrapped} {
+		_, httpResp, err := a.Client.ServiceAPI.ListServices(a.ClientCtx).Execute()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		httpResp.Body.Close()
+	}
+
+	if got := strings.Join(keys, ","); got != "original,rotated" {
+		t.Errorf("expected only the wrapped client to use the middleware, got %s", got)
+	}
+}
+
+func TestBodyMiddleware(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		got = string(b)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	body := "streamed"
+	open := func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(body)), nil
+	}
+	client := &http.Client{Transport: Chain(nil, BodyMiddleware(open, int64(len(body))))}
+	resp, err := client.Post(server.URL, "application/octet-stream", strings.NewReader("placeholder"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if got != body {
+		t.Errorf("expected the body to be replaced, got %q", got)
+	}
+}
+
+func TestValidateOnlyMiddleware(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: Chain(nil, ValidateOnlyMiddleware)}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	_, err = client.Post(server.URL+"/service", "application/x-www-form-urlencoded", strings.NewReader("name=test"))
+	if !errors.Is(err, ErrValidateOnly) {
+		t.Errorf("expected the POST to be refused, got: %v", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("expected only the GET to reach the server, got %d requests", requests)
+	}
+}
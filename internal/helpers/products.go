@@ -0,0 +1,79 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Product IDs accepted by the product enablement API.
+const (
+	// ProductBotManagement is the Bot Management product.
+	ProductBotManagement = "bot_management"
+	// ProductBrotliCompression is the Brotli compression product.
+	ProductBrotliCompression = "brotli_compression"
+	// ProductDomainInspector is the Domain Inspector (per-domain metrics) product.
+	ProductDomainInspector = "domain_inspector"
+	// ProductFanout is the Fanout (GRIP) product for Compute services.
+	ProductFanout = "fanout"
+	// ProductImageOptimizer is the Image Optimizer product.
+	ProductImageOptimizer = "image_optimizer"
+	// ProductOriginInspector is the Origin Inspector (per-origin metrics) product.
+	ProductOriginInspector = "origin_inspector"
+	// ProductWebSockets is the WebSockets passthrough product.
+	ProductWebSockets = "websockets"
+)
+
+// EnableProduct enables the product for the service.
+func EnableProduct(ctx context.Context, api API, productID, serviceID string, diags *diag.Diagnostics) error {
+	clientReq := api.Client.EnabledProductsAPI.EnableProduct(api.ClientCtx, productID, serviceID)
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly EnabledProductsAPI.EnableProduct error", map[string]any{"http_resp": LogResponse(httpResp)})
+		APIError(httpResp, err, diags, fmt.Sprintf("Unable to enable product %s", productID))
+		return err
+	}
+	defer httpResp.Body.Close()
+	return CheckStatus(ctx, httpResp, diags)
+}
+
+// DisableProduct disables the product for the service.
+//
+// NOTE: A product that is already disabled (404) is not considered an error.
+func DisableProduct(ctx context.Context, api API, productID, serviceID string, diags *diag.Diagnostics) error {
+	clientReq := api.Client.EnabledProductsAPI.DisableProduct(api.ClientCtx, productID, serviceID)
+	httpResp, err := clientReq.Execute()
+	if err != nil {
+		if IsNotFound(httpResp) {
+			return nil
+		}
+		tflog.Trace(ctx, "Fastly EnabledProductsAPI.DisableProduct error", map[string]any{"http_resp": LogResponse(httpResp)})
+		APIError(httpResp, err, diags, fmt.Sprintf("Unable to disable product %s", productID))
+		return err
+	}
+	defer httpResp.Body.Close()
+	return CheckStatus(ctx, httpResp, diags)
+}
+
+// ProductEnabled indicates if the product is enabled for the service.
+//
+// NOTE: The API returns a 404 if the product isn't enabled.
+func ProductEnabled(ctx context.Context, api API, productID, serviceID string, diags *diag.Diagnostics) (bool, error) {
+	clientReq := api.Client.EnabledProductsAPI.GetEnabledProduct(api.ClientCtx, productID, serviceID)
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		if IsNotFound(httpResp) {
+			return false, nil
+		}
+		tflog.Trace(ctx, "Fastly EnabledProductsAPI.GetEnabledProduct error", map[string]any{"http_resp": LogResponse(httpResp)})
+		APIError(httpResp, err, diags, fmt.Sprintf("Unable to read product %s", productID))
+		return false, err
+	}
+	defer httpResp.Body.Close()
+	if err := CheckStatus(ctx, httpResp, diags); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,21 @@
+package helpers
+
+import (
+	"github.com/fastly/fastly-go/fastly"
+)
+
+// ProviderData is the provider-level data made available to all resources and
+// data sources (see `Configure()` in ../provider/provider.go).
+type ProviderData struct {
+	// APITiming controls the reporting of API call timings.
+	APITiming APITiming
+	// Client is a preconfigured instance of the Fastly API client.
+	Client *fastly.APIClient
+	// Token describes the API token, so resources can check its scope.
+	Token *TokenInfo
+	// Tracer exports the spans of resource operations (nil if tracing is disabled).
+	Tracer *Tracer
+	// WarnDuplicateServiceNames enables a plan-time check for services that
+	// already use the configured service name.
+	WarnDuplicateServiceNames bool
+}
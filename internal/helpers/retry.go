@@ -0,0 +1,157 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DefaultMaxRetries is the number of times an idempotent API call is retried
+// after a transient network failure, unless configured otherwise.
+const DefaultMaxRetries = 3
+
+// defaultRetryBackoff is the delay before the first retry.
+// The delay is doubled for each subsequent retry.
+const defaultRetryBackoff = 500 * time.Millisecond
+
+// RetryTransport is a http.RoundTripper that retries idempotent requests
+// (GET, HEAD, OPTIONS, DELETE and the PUT requests in idempotentPuts) which
+// fail because of a transient network error, such as a connection reset or a
+// timeout.
+//
+// NOTE: Only network errors are retried.
+// A response with an unsuccessful HTTP status code is returned as-is.
+type RetryTransport struct {
+	// Backoff is the delay before the first retry.
+	Backoff time.Duration
+	// MaxRetries is the maximum number of retries (zero disables retries).
+	MaxRetries int
+	// Transport is the underlying http.RoundTripper (http.DefaultTransport if nil).
+	Transport http.RoundTripper
+}
+
+// NewRetryTransport returns a RetryTransport wrapping transport.
+func NewRetryTransport(transport http.RoundTripper, maxRetries int) *RetryTransport {
+	return &RetryTransport{
+		Backoff:    defaultRetryBackoff,
+		MaxRetries: maxRetries,
+		Transport:  transport,
+	}
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	if t.MaxRetries <= 0 || !isIdempotent(req) {
+		return transport.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	backoff := t.Backoff
+
+	for attempt := 1; ; attempt++ {
+		resp, err := transport.RoundTrip(req)
+		if err == nil || attempt > t.MaxRetries || !isTransient(ctx, err) {
+			return resp, err
+		}
+
+		// The request body has been consumed, so it must be recreated.
+		// If that's not possible, then the request can't be safely retried.
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+
+		tflog.Debug(ctx, "Retrying API call after network error", map[string]any{
+			"attempt": attempt,
+			"error":   err.Error(),
+			"method":  req.Method,
+			"url":     req.URL.Redacted(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// idempotentPuts match the paths of the PUT requests that can be safely
+// repeated (i.e. a repeated request leaves the object in the same state).
+//
+// NOTE: Not every PUT is idempotent in the Fastly API. For example, cloning a
+// service version (PUT /service/{id}/version/{n}/clone) creates a new version
+// for every request, so a retry could orphan a cloned version. A conditional
+// KV store write is also excluded, as a retry of a write that succeeded would
+// fail its precondition.
+var idempotentPuts = []*regexp.Regexp{
+	// Updating a service's attributes (e.g. its name or comment).
+	regexp.MustCompile(`^/service/[^/]+$`),
+	// Activating, deactivating or locking a service version (repeating the
+	// request leaves the version in the same state), or replacing its settings
+	// or package.
+	regexp.MustCompile(`^/service/[^/]+/version/\d+/(activate|deactivate|lock|package|settings)$`),
+	// Updating a named object of a service version.
+	regexp.MustCompile(`^/service/[^/]+/version/\d+/(dictionary|domain|logging/[^/]+)/[^/]+$`),
+	// Upserting a dictionary item.
+	regexp.MustCompile(`^/service/[^/]+/dictionary/[^/]+/item/[^/]+$`),
+	// Enabling a product.
+	regexp.MustCompile(`^/enabled-products/[^/]+/services/[^/]+$`),
+	// Upserting a config store item.
+	regexp.MustCompile(`^/resources/stores/config/[^/]+/item/[^/]+$`),
+}
+
+// isIdempotent reports whether the request can be safely repeated.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodDelete:
+		return true
+	case http.MethodPut:
+		for _, re := range idempotentPuts {
+			if re.MatchString(req.URL.Path) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isTransient reports whether err is a network error that might not occur if
+// the request is retried.
+func isTransient(ctx context.Context, err error) bool {
+	// The caller gave up (e.g. the operation was cancelled or timed out).
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,34 @@
+package helpers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIsIdempotent(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   bool
+	}{
+		{http.MethodGet, "/service/123/version/1/domain", true},
+		{http.MethodDelete, "/service/123/version/1/domain/example.com", true},
+		{http.MethodPost, "/service/123/version/1/domain", false},
+		{http.MethodPatch, "/service/123/dictionary/456/items", false},
+		{http.MethodPut, "/service/123", true},
+		{http.MethodPut, "/service/123/version/1/activate", true},
+		{http.MethodPut, "/service/123/version/1/clone", false},
+		{http.MethodPut, "/service/123/version/1/domain/example.com", true},
+		{http.MethodPut, "/service/123/version/1/logging/kafka/example", true},
+		{http.MethodPut, "/service/123/dictionary/456/item/key", true},
+		{http.MethodPut, "/resources/stores/kv/123/keys/key", false},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		if got := isIdempotent(req); got != tt.want {
+			t.Errorf("%s %s: want %t, got %t", tt.method, tt.path, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package helpers
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// MergeState merges the top-level attributes that differ from base in each of
+// the given values into a single value.
+//
+// This allows nested resources to mutate their own copy of the Terraform state
+// concurrently, as each nested resource only modifies its own attribute.
+func MergeState(base tftypes.Value, values ...tftypes.Value) (tftypes.Value, error) {
+	var baseAttrs map[string]tftypes.Value
+	if err := base.As(&baseAttrs); err != nil {
+		return base, fmt.Errorf("failed to convert state: %w", err)
+	}
+
+	merged := make(map[string]tftypes.Value, len(baseAttrs))
+	for k, v := range baseAttrs {
+		merged[k] = v
+	}
+
+	for _, value := range values {
+		var attrs map[string]tftypes.Value
+		if err := value.As(&attrs); err != nil {
+			return base, fmt.Errorf("failed to convert state: %w", err)
+		}
+		for k, v := range attrs {
+			if !v.Equal(baseAttrs[k]) {
+				merged[k] = v
+			}
+		}
+	}
+
+	return tftypes.NewValue(base.Type(), merged), nil
+}
+
+// HasStateAttribute indicates if the top-level attribute is set (i.e. known
+// and not null) within the given state value.
+func HasStateAttribute(state tftypes.Value, attribute string) bool {
+	v, _, err := tftypes.WalkAttributePath(state, tftypes.NewAttributePath().WithAttributeName(attribute))
+	if err != nil {
+		return false
+	}
+	value, ok := v.(tftypes.Value)
+	return ok && value.IsKnown() && !value.IsNull()
+}
+
+// PlanHasChanges indicates if the planned value would change the prior state
+// of a resource (which includes creating the resource, as there's no prior
+// state).
+func PlanHasChanges(plan, state tftypes.Value) bool {
+	return state.IsNull() || !plan.Equal(state)
+}
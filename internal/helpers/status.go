@@ -0,0 +1,75 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// IsSuccess reports whether the API response has a 2xx status code.
+//
+// NOTE: Several Fastly endpoints return 201 Created or 204 No Content.
+// So we can't only check for 200 OK.
+func IsSuccess(httpResp *http.Response) bool {
+	return httpResp.StatusCode >= http.StatusOK && httpResp.StatusCode < http.StatusMultipleChoices
+}
+
+// IsNotFound reports whether the API response has a 404 status code.
+//
+// NOTE: The API client returns an error for any status code >= 300.
+// But the response is still returned so we can check the status code.
+func IsNotFound(httpResp *http.Response) bool {
+	return httpResp != nil && httpResp.StatusCode == http.StatusNotFound
+}
+
+// CheckStatus validates the API response has a 2xx status code.
+//
+// If the status code is unsuccessful, an error diagnostic (explaining the most
+// common 4xx status codes) is appended and a non-nil error returned.
+func CheckStatus(ctx context.Context, httpResp *http.Response, diags *diag.Diagnostics) error {
+	if IsSuccess(httpResp) {
+		return nil
+	}
+
+	tflog.Trace(ctx, ErrorAPI, map[string]any{"http_resp": LogResponse(httpResp)})
+	diags.AddError(ErrorAPI, StatusDetail(httpResp))
+
+	return fmt.Errorf("unsuccessful status code: %s", httpResp.Status)
+}
+
+// StatusDetail returns a description of an unsuccessful API response.
+func StatusDetail(httpResp *http.Response) string {
+	switch httpResp.StatusCode {
+	case http.StatusBadRequest:
+		return fmt.Sprintf("The API rejected the request as invalid (%s). Check the values in your configuration.", httpResp.Status)
+	case http.StatusUnauthorized:
+		return fmt.Sprintf("The API token was not accepted (%s). Check %s is set to a valid API token.", httpResp.Status, APIKeyEnv)
+	case http.StatusForbidden:
+		return fmt.Sprintf("The API token is not permitted to perform this operation (%s). Check the token's scope and the user's role.", httpResp.Status)
+	case http.StatusNotFound:
+		return fmt.Sprintf("The requested object was not found (%s). It may have been deleted outside of Terraform.", httpResp.Status)
+	case http.StatusConflict:
+		return fmt.Sprintf("The request conflicts with the current state of the object (%s). Another change may be in progress or the service version may be locked.", httpResp.Status)
+	case http.StatusPreconditionFailed:
+		return fmt.Sprintf("The request's precondition failed (%s). The object may have been changed by another writer.", httpResp.Status)
+	case http.StatusTooManyRequests:
+		return fmt.Sprintf("The API rate limit has been exceeded (%s). Wait before retrying or reduce the parallelism of the apply.", httpResp.Status)
+	}
+	return fmt.Sprintf("Unsuccessful status code: %s", httpResp.Status)
+}
+
+// APIError appends an error diagnostic for an API call that failed with err.
+//
+// The message describes the failed operation (e.g. "Unable to list domains").
+// The API client returns an error for any status code >= 300, so if the API
+// responded then the status code is also explained (see StatusDetail).
+func APIError(httpResp *http.Response, err error, diags *diag.Diagnostics, message string) {
+	detail := fmt.Sprintf("%s, got error: %s", message, err)
+	if httpResp != nil && !IsSuccess(httpResp) {
+		detail += "\n\n" + StatusDetail(httpResp)
+	}
+	diags.AddError(ErrorAPIClient, detail)
+}
@@ -0,0 +1,35 @@
+package helpers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestAPIError(t *testing.T) {
+	err := errors.New("403 Forbidden")
+	httpResp := &http.Response{Status: "403 Forbidden", StatusCode: http.StatusForbidden}
+
+	var diags diag.Diagnostics
+	APIError(httpResp, err, &diags, "Unable to list domains")
+	if len(diags) != 1 {
+		t.Fatalf("want one diagnostic, got: %v", diags)
+	}
+	detail := diags[0].Detail()
+	if !strings.HasPrefix(detail, "Unable to list domains, got error: 403 Forbidden") {
+		t.Errorf("want the operation and error in the detail, got: %s", detail)
+	}
+	if !strings.Contains(detail, StatusDetail(httpResp)) {
+		t.Errorf("want the status code explained in the detail, got: %s", detail)
+	}
+
+	// A network error has no response to explain.
+	diags = nil
+	APIError(nil, errors.New("connection reset"), &diags, "Unable to list domains")
+	if detail := diags[0].Detail(); detail != "Unable to list domains, got error: connection reset" {
+		t.Errorf("want only the operation and error in the detail, got: %s", detail)
+	}
+}
@@ -0,0 +1,38 @@
+package helpers
+
+import (
+	"time"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NullableString reconciles a string attribute returned by the Fastly API with
+// the prior value of the attribute (from the state or config).
+//
+// The Fastly API returns an empty string (rather than null or omitting the
+// field) for string attributes that have never been set. Terraform stores an
+// unset attribute as <null>, so using the API value as-is would produce a
+// "plan was not empty" diff. We therefore return null if the API returns an
+// empty string and the prior value is null.
+//
+// NOTE: This means we can't distinguish an explicitly empty string set outside
+// of Terraform from an unset value. When there is no prior value (e.g. during
+// an import) an empty string is always treated as unset.
+func NullableString(remote *string, prior types.String) types.String {
+	if remote == nil {
+		return types.StringNull()
+	}
+	if *remote == "" && prior.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(*remote)
+}
+
+// Timestamp returns an API timestamp as an RFC 3339 string (or null if unset).
+func Timestamp(t fastly.NullableTime) types.String {
+	if v := t.Get(); v != nil {
+		return types.StringValue(v.Format(time.RFC3339))
+	}
+	return types.StringNull()
+}
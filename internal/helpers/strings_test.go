@@ -0,0 +1,63 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNullableString(t *testing.T) {
+	empty := ""
+	value := "example"
+
+	tests := map[string]struct {
+		remote *string
+		prior  types.String
+		want   types.String
+	}{
+		"nil remote with null prior": {
+			remote: nil,
+			prior:  types.StringNull(),
+			want:   types.StringNull(),
+		},
+		"nil remote with prior value": {
+			remote: nil,
+			prior:  types.StringValue(value),
+			want:   types.StringNull(),
+		},
+		"empty remote with null prior": {
+			remote: &empty,
+			prior:  types.StringNull(),
+			want:   types.StringNull(),
+		},
+		"empty remote with empty prior": {
+			remote: &empty,
+			prior:  types.StringValue(""),
+			want:   types.StringValue(""),
+		},
+		"empty remote with prior value": {
+			remote: &empty,
+			prior:  types.StringValue(value),
+			want:   types.StringValue(""),
+		},
+		"remote value with null prior": {
+			remote: &value,
+			prior:  types.StringNull(),
+			want:   types.StringValue(value),
+		},
+		"remote value with unknown prior": {
+			remote: &value,
+			prior:  types.StringUnknown(),
+			want:   types.StringValue(value),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := NullableString(tc.remote, tc.prior)
+			if !got.Equal(tc.want) {
+				t.Errorf("NullableString() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
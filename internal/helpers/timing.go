@@ -0,0 +1,152 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// APITiming controls how API call timings are reported.
+type APITiming string
+
+const (
+	// APITimingOff disables API call timing instrumentation.
+	APITimingOff APITiming = ""
+	// APITimingLog logs a summary of the API call timings.
+	APITimingLog APITiming = "log"
+	// APITimingWarn logs a summary and also emits it as a warning diagnostic.
+	APITimingWarn APITiming = "warn"
+)
+
+// apiTimingsKey is the context key for the APITimings recorder.
+type apiTimingsKey struct{}
+
+// APITimings records the latency and number of calls per API endpoint.
+type APITimings struct {
+	endpoints map[string]*EndpointTiming
+	mu        sync.Mutex
+}
+
+// EndpointTiming is the recorded timing data for a single API endpoint.
+type EndpointTiming struct {
+	// Calls is the number of calls made to the endpoint.
+	Calls int
+	// Max is the slowest call made to the endpoint.
+	Max time.Duration
+	// Total is the cumulative latency of all calls made to the endpoint.
+	Total time.Duration
+}
+
+// WithAPITimings returns a context that records API call timings.
+// The returned context should be used as the API client context.
+func WithAPITimings(ctx context.Context) (context.Context, *APITimings) {
+	timings := &APITimings{endpoints: make(map[string]*EndpointTiming)}
+	return context.WithValue(ctx, apiTimingsKey{}, timings), timings
+}
+
+// record stores the duration of a single API call.
+func (t *APITimings) record(endpoint string, d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	e, ok := t.endpoints[endpoint]
+	if !ok {
+		e = &EndpointTiming{}
+		t.endpoints[endpoint] = e
+	}
+	e.Calls++
+	e.Total += d
+	if d > e.Max {
+		e.Max = d
+	}
+}
+
+// Summary returns a human readable summary of the recorded API call timings.
+// Endpoints are ordered by their cumulative latency (slowest first).
+func (t *APITimings) Summary() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	endpoints := make([]string, 0, len(t.endpoints))
+	for endpoint := range t.endpoints {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		return t.endpoints[endpoints[i]].Total > t.endpoints[endpoints[j]].Total
+	})
+
+	lines := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		e := t.endpoints[endpoint]
+		lines = append(lines, fmt.Sprintf("%s: %d calls, total %s, max %s", endpoint, e.Calls, e.Total.Round(time.Millisecond), e.Max.Round(time.Millisecond)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ReportAPITimings reports the recorded API call timings for an operation.
+// It's a no-op if timings is nil (i.e. instrumentation is disabled).
+func ReportAPITimings(ctx context.Context, operation string, mode APITiming, timings *APITimings, diags *diag.Diagnostics) {
+	if timings == nil || mode == APITimingOff {
+		return
+	}
+
+	summary := timings.Summary()
+	if summary == "" {
+		return
+	}
+
+	tflog.Debug(ctx, "API call timings", map[string]any{"operation": operation, "summary": summary})
+
+	if mode == APITimingWarn {
+		diags.AddWarning("API Call Timings", fmt.Sprintf("%s:\n\n%s", operation, summary))
+	}
+}
+
+// TimingTransport is a http.RoundTripper that records the latency of each API
+// call into the APITimings recorder found in the request context (if any).
+type TimingTransport struct {
+	// Transport is the underlying http.RoundTripper (http.DefaultTransport if nil).
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *TimingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	timings, ok := req.Context().Value(apiTimingsKey{}).(*APITimings)
+	if !ok {
+		return transport.RoundTrip(req)
+	}
+
+	start := time.Now()
+	resp, err := transport.RoundTrip(req)
+	timings.record(req.Method+" "+endpointPattern(req.URL.Path), time.Since(start))
+
+	return resp, err
+}
+
+// endpointPattern replaces the variable segments of a URL path (e.g. service
+// IDs, version numbers and domain names) so calls can be grouped by endpoint.
+//
+// NOTE: A segment is presumed to be variable if it contains a digit or a dot.
+// e.g. /service/SU1Z0isxPaozGVKXdv0eY/version/1/domain -> /service/:id/version/:id/domain
+func endpointPattern(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.ContainsRune(segment, '.') || strings.IndexFunc(segment, unicode.IsDigit) >= 0 {
+			segments[i] = ":id"
+		}
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,131 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// API token scopes.
+// https://www.fastly.com/documentation/reference/api/auth-tokens/#scopes
+const (
+	// ScopeGlobal permits all API calls (including purges).
+	ScopeGlobal = "global"
+	// ScopeGlobalRead permits read-only API calls.
+	ScopeGlobalRead = "global:read"
+	// ScopePurgeAll permits purging all content of a service.
+	ScopePurgeAll = "purge_all"
+	// ScopePurgeSelect permits purging content by URL or surrogate key.
+	ScopePurgeSelect = "purge_select"
+)
+
+// ErrorTokenScope is the summary of a diagnostic for an API token that isn't
+// permitted to manage a resource.
+const ErrorTokenScope = "Insufficient API Token Scope"
+
+// ErrorCustomerMismatch is the summary of a diagnostic for an API token that
+// belongs to a different Fastly customer than the provider is configured for.
+const ErrorCustomerMismatch = "API Token Customer Mismatch"
+
+// TokenInfo describes the API token used by the provider.
+//
+// The token is looked up once (and only when first needed), so resources can
+// check the token is permitted to make the required API calls when planning,
+// rather than failing with a 403 Forbidden part way through an apply.
+type TokenInfo struct {
+	once sync.Once
+	// found indicates the token was successfully looked up.
+	found bool
+	// scopes are the scopes granted to the token.
+	scopes []string
+	// services are the services the token is limited to (empty for all services).
+	services []string
+}
+
+// CheckScope appends an error diagnostic if the API token has none of the
+// given scopes, or is limited to services that don't include the service ID
+// (which is ignored if empty).
+//
+// If the plan makes no changes (see PlanHasChanges), then a missing scope is
+// only a warning, as a read-only token (e.g. `global:read` in a CI pipeline
+// that only runs `terraform plan`) is permitted to refresh the resource.
+//
+// NOTE: If the token can't be looked up (e.g. the API is unreachable) then the
+// check is skipped, as any problem will be reported by the apply.
+func (t *TokenInfo) CheckScope(ctx context.Context, api API, resourceType, serviceID string, changes bool, diags *diag.Diagnostics, scopes ...string) {
+	if t == nil || api.Client == nil {
+		return
+	}
+
+	t.once.Do(func() { t.lookup(ctx, api) })
+	if !t.found {
+		return
+	}
+
+	if !slices.ContainsFunc(scopes, func(s string) bool { return slices.Contains(t.scopes, s) }) {
+		detail := fmt.Sprintf("The API token (scope: %s) is not permitted to manage %s, which requires a token with the %s scope. Set %s to a token with the required scope.", strings.Join(t.scopes, ", "), resourceType, strings.Join(scopes, " or "), APIKeyEnv)
+		if !changes {
+			diags.AddWarning(ErrorTokenScope, detail+" The plan makes no changes to the resource, so it can be refreshed, but a change will fail to apply.")
+			return
+		}
+		diags.AddError(ErrorTokenScope, detail)
+		return
+	}
+
+	if serviceID != "" && len(t.services) > 0 && !slices.Contains(t.services, serviceID) {
+		diags.AddError(
+			ErrorTokenScope,
+			fmt.Sprintf("The API token is limited to specific services (%s) and is not permitted to manage %s for service '%s'. Set %s to a token with access to the service.", strings.Join(t.services, ", "), resourceType, serviceID, APIKeyEnv),
+		)
+	}
+}
+
+// CheckCustomer appends an error diagnostic if the API token doesn't belong to
+// the Fastly customer (account) with the given ID, so a token for the wrong
+// account can't be used to apply changes.
+//
+// NOTE: Unlike CheckScope, the check fails if the customer can't be looked up.
+func (t *TokenInfo) CheckCustomer(ctx context.Context, api API, customerID string, diags *diag.Diagnostics) {
+	clientResp, httpResp, err := api.Client.UserAPI.GetCurrentUser(api.ClientCtx).Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly UserAPI.GetCurrentUser error", map[string]any{"http_resp": LogResponse(httpResp)})
+		APIError(httpResp, err, diags, fmt.Sprintf("Unable to verify the API token belongs to customer '%s'", customerID))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if clientResp.GetCustomerID() == customerID {
+		return
+	}
+
+	// NOTE: The scope is included to help identify which token is being used.
+	t.once.Do(func() { t.lookup(ctx, api) })
+	scope := "unknown"
+	if t.found {
+		scope = strings.Join(t.scopes, ", ")
+	}
+
+	diags.AddError(
+		ErrorCustomerMismatch,
+		fmt.Sprintf("The API token (scope: %s) belongs to customer '%s', but the provider is configured for customer '%s'. Set %s to a token for the intended account, or update the provider's customer_id.", scope, clientResp.GetCustomerID(), customerID, APIKeyEnv),
+	)
+}
+
+// lookup reads the scopes and services of the API token.
+func (t *TokenInfo) lookup(ctx context.Context, api API) {
+	clientResp, httpResp, err := api.Client.TokensAPI.GetTokenCurrent(api.ClientCtx).Execute()
+	if err != nil {
+		tflog.Debug(ctx, "Unable to read the API token scope, skipping scope checks", map[string]any{"http_resp": LogResponse(httpResp), "error": err.Error()})
+		return
+	}
+	defer httpResp.Body.Close()
+
+	t.found = true
+	t.scopes = strings.Fields(clientResp.GetScope())
+	t.services = clientResp.GetServices()
+}
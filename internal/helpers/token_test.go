@@ -0,0 +1,120 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestTokenInfoCheckScope(t *testing.T) {
+	var lookups int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tokens/self" {
+			http.NotFound(w, r)
+			return
+		}
+		lookups++
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"scope":    "purge_select purge_all",
+			"services": []string{"service1"},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := fastly.NewConfiguration()
+	cfg.OperationServers = map[string]fastly.ServerConfigurations{
+		"TokensAPIService.GetTokenCurrent": {{URL: server.URL}},
+	}
+	api := API{Client: fastly.NewAPIClient(cfg), ClientCtx: context.Background()}
+
+	token := &TokenInfo{}
+
+	var diags diag.Diagnostics
+	token.CheckScope(context.Background(), api, "fastly_purge", "service1", true, &diags, ScopeGlobal, ScopePurgeSelect)
+	if diags.HasError() {
+		t.Errorf("unexpected error: %v", diags)
+	}
+
+	diags = nil
+	token.CheckScope(context.Background(), api, "fastly_service_vcl", "", true, &diags, ScopeGlobal)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "requires a token with the global scope") {
+		t.Errorf("expected a missing scope error, got: %v", diags)
+	}
+
+	// A plan without changes only needs a read-only token.
+	diags = nil
+	token.CheckScope(context.Background(), api, "fastly_service_vcl", "", false, &diags, ScopeGlobal)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("expected a missing scope warning, got: %v", diags)
+	}
+
+	diags = nil
+	token.CheckScope(context.Background(), api, "fastly_purge", "service2", true, &diags, ScopePurgeAll)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "limited to specific services") {
+		t.Errorf("expected a service restriction error, got: %v", diags)
+	}
+
+	if lookups != 1 {
+		t.Errorf("expected the token to be looked up once, got: %d", lookups)
+	}
+
+	// A failed lookup skips the check.
+	cfg.OperationServers["TokensAPIService.GetTokenCurrent"] = fastly.ServerConfigurations{{URL: server.URL + "/missing"}}
+	diags = nil
+	(&TokenInfo{}).CheckScope(context.Background(), api, "fastly_service_vcl", "", true, &diags, ScopeGlobal)
+	if diags.HasError() {
+		t.Errorf("expected the check to be skipped, got: %v", diags)
+	}
+
+	// A nil token (e.g. an unconfigured provider) skips the check.
+	var nilToken *TokenInfo
+	nilToken.CheckScope(context.Background(), api, "fastly_service_vcl", "", true, &diags, ScopeGlobal)
+}
+
+func TestTokenInfoCheckCustomer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/current_user":
+			_ = json.NewEncoder(w).Encode(map[string]any{"customer_id": "customer1"})
+		case "/tokens/self":
+			_ = json.NewEncoder(w).Encode(map[string]any{"scope": "global"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := fastly.NewConfiguration()
+	cfg.OperationServers = map[string]fastly.ServerConfigurations{
+		"TokensAPIService.GetTokenCurrent": {{URL: server.URL}},
+		"UserAPIService.GetCurrentUser":    {{URL: server.URL}},
+	}
+	api := API{Client: fastly.NewAPIClient(cfg), ClientCtx: context.Background()}
+
+	var diags diag.Diagnostics
+	(&TokenInfo{}).CheckCustomer(context.Background(), api, "customer1", &diags)
+	if diags.HasError() {
+		t.Errorf("unexpected error: %v", diags)
+	}
+
+	(&TokenInfo{}).CheckCustomer(context.Background(), api, "customer2", &diags)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "(scope: global) belongs to customer 'customer1'") {
+		t.Errorf("expected a customer mismatch error, got: %v", diags)
+	}
+
+	// A failed lookup fails the check.
+	cfg.OperationServers["UserAPIService.GetCurrentUser"] = fastly.ServerConfigurations{{URL: server.URL + "/missing"}}
+	diags = nil
+	(&TokenInfo{}).CheckCustomer(context.Background(), api, "customer1", &diags)
+	if !diags.HasError() {
+		t.Error("expected an error when the customer can't be looked up")
+	}
+}
@@ -0,0 +1,425 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// OTLPEndpointEnv is the standard OpenTelemetry environment variable for the
+// base URL of an OTLP collector, used if the provider's `otlp_endpoint`
+// attribute isn't set.
+const OTLPEndpointEnv = "OTEL_EXPORTER_OTLP_ENDPOINT"
+
+// OTLPHeadersEnv is the standard OpenTelemetry environment variable for the
+// headers sent to the OTLP collector (e.g. `authorization=Bearer abc,x-team=cdn`).
+const OTLPHeadersEnv = "OTEL_EXPORTER_OTLP_HEADERS"
+
+// TraceParentEnv is the W3C trace context environment variable, which a caller
+// (e.g. a CI pipeline) sets so the provider's spans join its existing trace.
+// https://www.w3.org/TR/trace-context/#traceparent-header
+const TraceParentEnv = "TRACEPARENT"
+
+// tracerServiceName is the `service.name` resource attribute of every span.
+const tracerServiceName = "terraform-provider-fastly"
+
+// tracerExportTimeout is the maximum duration of a single span export.
+const tracerExportTimeout = 5 * time.Second
+
+// OTLP span kinds and status codes.
+// https://opentelemetry.io/docs/specs/otlp/
+const (
+	spanKindInternal = 1
+	spanKindClient   = 3
+	spanStatusError  = 2
+)
+
+// spanKey is the context key for the operation Span.
+type spanKey struct{}
+
+// Tracer exports spans describing the provider operations and the Fastly API
+// calls they make to an OpenTelemetry collector, using OTLP/HTTP with the JSON
+// encoding.
+//
+// NOTE: A nil Tracer is valid and disables tracing. Exporting is best-effort,
+// so a collector that can't be reached is logged but never fails an operation.
+type Tracer struct {
+	// Client is the HTTP client used to export spans (http.DefaultClient if nil).
+	// It must not be the Fastly API client, or the export would be traced.
+	Client *http.Client
+	// Endpoint is the OTLP/HTTP traces URL (e.g. http://localhost:4318/v1/traces).
+	Endpoint string
+	// Headers are sent with every export (e.g. for authentication).
+	Headers map[string]string
+	// Parent is the W3C traceparent of the caller's span (e.g.
+	// `00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01`). If it's valid,
+	// each operation is exported as a child of the caller's span, otherwise
+	// each operation starts a new trace.
+	Parent string
+	// Version is the provider version, set as the `service.version` attribute.
+	Version string
+}
+
+// NewTracer returns a Tracer exporting to the traces path of the given base
+// URL, or nil (disabling tracing) if the URL is empty.
+//
+// The headers are read from the OTLPHeadersEnv environment variable, and the
+// parent span from the TraceParentEnv environment variable.
+func NewTracer(baseURL, version string) *Tracer {
+	if baseURL == "" {
+		return nil
+	}
+
+	headers := make(map[string]string)
+	for _, pair := range strings.Split(os.Getenv(OTLPHeadersEnv), ",") {
+		if k, v, ok := strings.Cut(pair, "="); ok && strings.TrimSpace(k) != "" {
+			headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+
+	return &Tracer{
+		Client:   &http.Client{Timeout: tracerExportTimeout},
+		Endpoint: strings.TrimSuffix(baseURL, "/") + "/v1/traces",
+		Headers:  headers,
+		Parent:   os.Getenv(TraceParentEnv),
+		Version:  version,
+	}
+}
+
+// Span is a single timed operation (e.g. a resource Create) or API call.
+type Span struct {
+	// Attributes describe the operation (e.g. the HTTP method of an API call).
+	Attributes map[string]string
+	// End is when the operation finished.
+	End time.Time
+	// Error describes why the operation failed (empty if it succeeded).
+	Error string
+	// Name is the name of the operation (e.g. `fastly_service_vcl.Create`).
+	Name string
+	// Start is when the operation started.
+	Start time.Time
+
+	children []*Span
+	id       [8]byte
+	kind     int
+	mu       sync.Mutex
+	parentID [8]byte
+	traceID  [16]byte
+	tracer   *Tracer
+}
+
+// WithSpan returns a context for an operation span, so the API calls made with
+// the returned context (see TracingTransport) are recorded as child spans.
+// The returned context should be used as the API client context.
+//
+// It returns the context unchanged, and a nil Span, if tracing is disabled.
+func (t *Tracer) WithSpan(ctx context.Context, name string) (context.Context, *Span) {
+	if t == nil {
+		return ctx, nil
+	}
+
+	span := &Span{
+		Attributes: make(map[string]string),
+		Name:       name,
+		Start:      time.Now(),
+		id:         newSpanID(),
+		kind:       spanKindInternal,
+		tracer:     t,
+	}
+	span.traceID, span.parentID = t.newTrace()
+
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// Finish ends the operation span, marking it as failed if diags has an error,
+// and exports it (along with the child API call spans).
+// It's a no-op if s is nil (i.e. tracing is disabled).
+func (s *Span) Finish(ctx context.Context, diags diag.Diagnostics) {
+	if s == nil {
+		return
+	}
+
+	s.End = time.Now()
+	for _, d := range diags.Errors() {
+		s.Error = d.Summary()
+		break
+	}
+
+	s.mu.Lock()
+	spans := append([]*Span{s}, s.children...)
+	s.mu.Unlock()
+
+	s.tracer.export(ctx, spans)
+}
+
+// child records a completed API call made within the operation span.
+func (s *Span) child(c *Span) {
+	c.parentID = s.id
+	c.traceID = s.traceID
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.children = append(s.children, c)
+}
+
+// Operation returns a copy of api for a single resource or data source
+// operation (e.g. `fastly_package.Create`), so the API calls made with it are
+// recorded as child spans of the operation span. The returned function should
+// be deferred, so the operation span is exported once the operation completes.
+//
+// It returns api unchanged if tracing is disabled.
+func (t *Tracer) Operation(ctx context.Context, api API, name string) (API, func(diags *diag.Diagnostics)) {
+	var span *Span
+	api.ClientCtx, span = t.WithSpan(api.ClientCtx, name)
+
+	return api, func(diags *diag.Diagnostics) {
+		span.Finish(ctx, *diags)
+	}
+}
+
+// newTrace returns the trace ID and parent span ID of a root span, which joins
+// the caller's trace if the Parent is a valid traceparent.
+func (t *Tracer) newTrace() (traceID [16]byte, parentID [8]byte) {
+	if traceID, parentID, ok := parseTraceParent(t.Parent); ok {
+		return traceID, parentID
+	}
+
+	_, _ = rand.Read(traceID[:])
+	return traceID, parentID
+}
+
+// parseTraceParent returns the trace ID and parent span ID of a W3C traceparent
+// (`{version}-{trace-id}-{parent-id}-{trace-flags}`).
+//
+// NOTE: Later versions of the format may append fields, which are ignored.
+func parseTraceParent(traceParent string) (traceID [16]byte, parentID [8]byte, ok bool) {
+	fields := strings.Split(strings.TrimSpace(traceParent), "-")
+	if len(fields) < 4 || fields[0] == "ff" || (fields[0] == "00" && len(fields) != 4) {
+		return traceID, parentID, false
+	}
+
+	version, err1 := hex.DecodeString(fields[0])
+	trace, err2 := hex.DecodeString(fields[1])
+	parent, err3 := hex.DecodeString(fields[2])
+	flags, err4 := hex.DecodeString(fields[3])
+	if err := errors.Join(err1, err2, err3, err4); err != nil ||
+		len(version) != 1 || len(trace) != len(traceID) || len(parent) != len(parentID) || len(flags) != 1 {
+		return traceID, parentID, false
+	}
+
+	copy(traceID[:], trace)
+	copy(parentID[:], parent)
+
+	// An all-zero trace ID or parent ID is invalid.
+	if traceID == [16]byte{} || parentID == [8]byte{} {
+		return traceID, parentID, false
+	}
+
+	return traceID, parentID, true
+}
+
+// export sends the spans to the collector, logging (rather than returning) any
+// error so tracing can't fail an operation.
+func (t *Tracer) export(ctx context.Context, spans []*Span) {
+	body, err := json.Marshal(t.payload(spans))
+	if err != nil {
+		tflog.Warn(ctx, "Unable to encode the trace spans", map[string]any{"error": err.Error()})
+		return
+	}
+
+	// NOTE: The export isn't bound to the operation context, as the operation
+	// might have been cancelled (e.g. it exceeded its timeout).
+	exportCtx, cancel := context.WithTimeout(context.Background(), tracerExportTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(exportCtx, http.MethodPost, t.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		tflog.Warn(ctx, "Unable to export the trace spans", map[string]any{"error": err.Error()})
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range t.Headers {
+		req.Header.Set(k, v)
+	}
+
+	client := t.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to export the trace spans", map[string]any{"endpoint": t.Endpoint, "error": err.Error()})
+		return
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		tflog.Warn(ctx, "Unable to export the trace spans", map[string]any{"endpoint": t.Endpoint, "status": resp.Status})
+	}
+}
+
+// otlpKeyValue is an OTLP attribute with a string value.
+type otlpKeyValue struct {
+	Key   string `json:"key"`
+	Value struct {
+		StringValue string `json:"stringValue"`
+	} `json:"value"`
+}
+
+// otlpSpan is the OTLP JSON encoding of a span.
+type otlpSpan struct {
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Kind              int            `json:"kind"`
+	Name              string         `json:"name"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	SpanID            string         `json:"spanId"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	Status            *otlpStatus    `json:"status,omitempty"`
+	TraceID           string         `json:"traceId"`
+}
+
+// otlpStatus is the OTLP JSON encoding of a span status.
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+// payload returns the OTLP JSON request body (an ExportTraceServiceRequest).
+func (t *Tracer) payload(spans []*Span) map[string]any {
+	resourceAttrs := map[string]string{"service.name": tracerServiceName}
+	if t.Version != "" {
+		resourceAttrs["service.version"] = t.Version
+	}
+
+	encoded := make([]otlpSpan, 0, len(spans))
+	for _, s := range spans {
+		span := otlpSpan{
+			Attributes:        otlpAttributes(s.Attributes),
+			EndTimeUnixNano:   strconv.FormatInt(s.End.UnixNano(), 10),
+			Kind:              s.kind,
+			Name:              s.Name,
+			SpanID:            hex.EncodeToString(s.id[:]),
+			StartTimeUnixNano: strconv.FormatInt(s.Start.UnixNano(), 10),
+			TraceID:           hex.EncodeToString(s.traceID[:]),
+		}
+		if s.parentID != [8]byte{} {
+			span.ParentSpanID = hex.EncodeToString(s.parentID[:])
+		}
+		if s.Error != "" {
+			span.Status = &otlpStatus{Code: spanStatusError, Message: s.Error}
+		}
+		encoded = append(encoded, span)
+	}
+
+	return map[string]any{
+		"resourceSpans": []any{map[string]any{
+			"resource": map[string]any{"attributes": otlpAttributes(resourceAttrs)},
+			"scopeSpans": []any{map[string]any{
+				"scope": map[string]any{"name": tracerServiceName},
+				"spans": encoded,
+			}},
+		}},
+	}
+}
+
+// otlpAttributes returns the OTLP encoding of the attributes (sorted by key,
+// so the payload is deterministic).
+func otlpAttributes(attrs map[string]string) []otlpKeyValue {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	kvs := make([]otlpKeyValue, 0, len(keys))
+	for _, k := range keys {
+		kv := otlpKeyValue{Key: k}
+		kv.Value.StringValue = attrs[k]
+		kvs = append(kvs, kv)
+	}
+	return kvs
+}
+
+// newSpanID returns a random span ID.
+func newSpanID() [8]byte {
+	var id [8]byte
+	_, _ = rand.Read(id[:])
+	return id
+}
+
+// TracingTransport is a http.RoundTripper that records a span for each API
+// call. If the request context has an operation span (see Tracer.WithSpan),
+// the API call is recorded as a child span that's exported with the operation,
+// otherwise the API call is exported as a root span (see Tracer.Parent).
+type TracingTransport struct {
+	// Tracer exports the spans of API calls made outside of an operation span.
+	// Tracing is disabled if nil.
+	Tracer *Tracer
+	// Transport is the underlying http.RoundTripper (http.DefaultTransport if nil).
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *TracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	parent, _ := req.Context().Value(spanKey{}).(*Span)
+	if parent == nil && t.Tracer == nil {
+		return transport.RoundTrip(req)
+	}
+
+	endpoint := endpointPattern(req.URL.Path)
+	span := &Span{
+		Attributes: map[string]string{
+			"http.request.method": req.Method,
+			"url.path":            endpoint,
+			"server.address":      req.URL.Host,
+		},
+		Name:  req.Method + " " + endpoint,
+		Start: time.Now(),
+		id:    newSpanID(),
+		kind:  spanKindClient,
+	}
+
+	resp, err := transport.RoundTrip(req)
+	span.End = time.Now()
+
+	switch {
+	case err != nil:
+		span.Error = err.Error()
+	case resp.StatusCode >= http.StatusBadRequest:
+		span.Error = resp.Status
+	}
+	if resp != nil {
+		span.Attributes["http.response.status_code"] = strconv.Itoa(resp.StatusCode)
+	}
+
+	if parent != nil {
+		parent.child(span)
+	} else {
+		span.traceID, span.parentID = t.Tracer.newTrace()
+		t.Tracer.export(req.Context(), []*Span{span})
+	}
+
+	return resp, err
+}
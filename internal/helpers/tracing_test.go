@@ -0,0 +1,153 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// exportedSpans is the subset of the OTLP JSON payload checked by the tests.
+type exportedSpans struct {
+	ResourceSpans []struct {
+		ScopeSpans []struct {
+			Spans []otlpSpan `json:"spans"`
+		} `json:"scopeSpans"`
+	} `json:"resourceSpans"`
+}
+
+func TestTracer(t *testing.T) {
+	t.Setenv(OTLPHeadersEnv, "authorization=Bearer abc")
+	t.Setenv(TraceParentEnv, "")
+
+	var (
+		mu      sync.Mutex
+		exports [][]otlpSpan
+	)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" || r.Header.Get("Authorization") != "Bearer abc" {
+			t.Errorf("unexpected export request: %s (authorization: %q)", r.URL.Path, r.Header.Get("Authorization"))
+		}
+		var payload exportedSpans
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("failed to decode export: %s", err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		exports = append(exports, payload.ResourceSpans[0].ScopeSpans[0].Spans)
+	}))
+	defer collector.Close()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer api.Close()
+
+	tracer := NewTracer(collector.URL+"/", "test")
+	client := &http.Client{Transport: &TracingTransport{Tracer: tracer}}
+
+	// An API call within an operation is exported with the operation.
+	ctx, span := tracer.WithSpan(context.Background(), "fastly_service_vcl.Create")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, api.URL+"/service/SU1Z0isxPaozGVKXdv0eY/details", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+	if len(exports) != 0 {
+		t.Fatalf("expected no export before the operation finished, got %d", len(exports))
+	}
+
+	var diags diag.Diagnostics
+	diags.AddError("boom", "detail")
+	span.Finish(ctx, diags)
+
+	if len(exports) != 1 || len(exports[0]) != 2 {
+		t.Fatalf("expected one export of two spans, got %+v", exports)
+	}
+	op, call := exports[0][0], exports[0][1]
+	if op.Name != "fastly_service_vcl.Create" || op.Status == nil || op.Status.Message != "boom" {
+		t.Errorf("unexpected operation span: %+v", op)
+	}
+	if call.Name != "GET /service/:id/details" || call.ParentSpanID != op.SpanID || call.TraceID != op.TraceID || call.Status == nil {
+		t.Errorf("unexpected API call span: %+v", call)
+	}
+
+	// An API call outside of an operation is exported as its own trace.
+	resp, err = client.Get(api.URL + "/service")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+	if len(exports) != 2 || len(exports[1]) != 1 || exports[1][0].ParentSpanID != "" {
+		t.Fatalf("expected a single root span to be exported, got %+v", exports)
+	}
+}
+
+func TestTracerDisabled(t *testing.T) {
+	tracer := NewTracer("", "test")
+	if tracer != nil {
+		t.Fatalf("expected a nil tracer, got %+v", tracer)
+	}
+
+	ctx := context.Background()
+	spanCtx, span := tracer.WithSpan(ctx, "operation")
+	if spanCtx != ctx || span != nil {
+		t.Error("expected the context to be unchanged and a nil span")
+	}
+	span.Finish(ctx, nil)
+}
+
+func TestTracerParent(t *testing.T) {
+	t.Setenv(TraceParentEnv, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	var exports [][]otlpSpan
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload exportedSpans
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("failed to decode export: %s", err)
+		}
+		exports = append(exports, payload.ResourceSpans[0].ScopeSpans[0].Spans)
+	}))
+	defer collector.Close()
+
+	tracer := NewTracer(collector.URL, "test")
+	api, finishSpan := tracer.Operation(context.Background(), API{ClientCtx: context.Background()}, "fastly_package.Read")
+	if span, _ := api.ClientCtx.Value(spanKey{}).(*Span); span == nil || span.Name != "fastly_package.Read" {
+		t.Fatalf("expected the API client context to have the operation span, got %+v", span)
+	}
+	finishSpan(&diag.Diagnostics{})
+
+	if len(exports) != 1 || len(exports[0]) != 1 {
+		t.Fatalf("expected one export of one span, got %+v", exports)
+	}
+	op := exports[0][0]
+	if op.TraceID != "4bf92f3577b34da6a3ce929d0e0e4736" || op.ParentSpanID != "00f067aa0ba902b7" || op.Status != nil {
+		t.Errorf("expected the operation to join the caller's trace, got %+v", op)
+	}
+}
+
+func TestParseTraceParent(t *testing.T) {
+	for _, tc := range []struct {
+		traceParent string
+		valid       bool
+	}{
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", true},
+		{"01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra", true},
+		{"", false},
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra", false},
+		{"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", false},
+		{"00-00000000000000000000000000000000-00f067aa0ba902b7-01", false},
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01", false},
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736ab-00f067aa0ba902b7-01", false},
+		{"00-4bf92f3577b34da6a3ce929d0e0e473z-00f067aa0ba902b7-01", false},
+	} {
+		if _, _, ok := parseTraceParent(tc.traceParent); ok != tc.valid {
+			t.Errorf("parseTraceParent(%q): want valid %t, got %t", tc.traceParent, tc.valid, ok)
+		}
+	}
+}
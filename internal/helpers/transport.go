@@ -0,0 +1,213 @@
+package helpers
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultConditionalCacheSize is the maximum number of responses cached by a
+// ConditionalTransport, unless configured otherwise.
+const DefaultConditionalCacheSize = 256
+
+// ConditionalTransport is a http.RoundTripper that revalidates GET responses
+// which included an ETag header, using an If-None-Match header.
+//
+// Only requests made with ReadConditional are revalidated. If the Fastly API
+// responds with a 304 Not Modified, then the response is returned with an
+// empty body (which the API client doesn't decode) and ReadConditional returns
+// the value it decoded from the original response. This avoids transferring
+// and decoding unchanged payloads.
+//
+// NOTE: The cache is bounded (the least recently used response is evicted).
+// It's keyed by the request method, URL, Accept header and API token, so a
+// cached response is never returned for a request made with another token.
+type ConditionalTransport struct {
+	// MaxEntries is the maximum number of cached responses (DefaultConditionalCacheSize if zero).
+	MaxEntries int
+	// Transport is the underlying http.RoundTripper (http.DefaultTransport if nil).
+	Transport http.RoundTripper
+
+	entries map[string]*list.Element
+	hits    atomic.Int64
+	lru     *list.List
+	misses  atomic.Int64
+	mu      sync.Mutex
+}
+
+// conditionalEntry is a decoded response previously returned with an ETag.
+type conditionalEntry struct {
+	etag  string
+	key   string
+	value any
+}
+
+// conditionalContextKey is the context key for a conditionalRequest.
+type conditionalContextKey struct{}
+
+// conditionalRequest records the outcome of a request made with
+// ReadConditional, so the decoded value can be cached (or the cached value
+// returned) once the API client has processed the response.
+type conditionalRequest struct {
+	// etag is the ETag of a modified response.
+	etag string
+	// key is the cache key of the request.
+	key string
+	// notModified indicates the API responded with a 304 Not Modified.
+	notModified bool
+	// transport is the ConditionalTransport that made the request (nil if the
+	// client doesn't use a ConditionalTransport).
+	transport *ConditionalTransport
+	// value is the cached value (if notModified).
+	value any
+}
+
+// NewConditionalTransport returns a ConditionalTransport wrapping transport.
+func NewConditionalTransport(transport http.RoundTripper) *ConditionalTransport {
+	return &ConditionalTransport{
+		MaxEntries: DefaultConditionalCacheSize,
+		Transport:  transport,
+	}
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *ConditionalTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	cr, ok := req.Context().Value(conditionalContextKey{}).(*conditionalRequest)
+	if !ok || req.Method != http.MethodGet {
+		return transport.RoundTrip(req)
+	}
+
+	cr.key = conditionalCacheKey(req)
+	cr.transport = t
+
+	cached, found := t.get(cr.key)
+	if found && req.Header.Get("If-None-Match") == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", cached.etag)
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if found && resp.StatusCode == http.StatusNotModified {
+		t.hits.Add(1)
+		cr.notModified = true
+		cr.value = cached.value
+		_ = resp.Body.Close()
+		return &http.Response{
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+			Proto:      resp.Proto,
+			ProtoMajor: resp.ProtoMajor,
+			ProtoMinor: resp.ProtoMinor,
+			Header:     resp.Header.Clone(),
+			Body:       http.NoBody,
+			Request:    req,
+		}, nil
+	}
+
+	t.misses.Add(1)
+
+	if resp.StatusCode == http.StatusOK {
+		cr.etag = resp.Header.Get("ETag")
+	}
+
+	return resp, nil
+}
+
+// Stats returns the number of cache hits (304 Not Modified) and misses.
+func (t *ConditionalTransport) Stats() (hits, misses int64) {
+	return t.hits.Load(), t.misses.Load()
+}
+
+// get returns the cached entry for the key (marking it as recently used).
+func (t *ConditionalTransport) get(key string) (conditionalEntry, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	e, ok := t.entries[key]
+	if !ok {
+		return conditionalEntry{}, false
+	}
+	t.lru.MoveToFront(e)
+	return e.Value.(conditionalEntry), true
+}
+
+// set caches the decoded value of a response, evicting the least recently
+// used entry if the cache is full.
+func (t *ConditionalTransport) set(key, etag string, value any) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.entries == nil {
+		t.entries = make(map[string]*list.Element)
+		t.lru = list.New()
+	}
+
+	entry := conditionalEntry{etag: etag, key: key, value: value}
+	if e, ok := t.entries[key]; ok {
+		e.Value = entry
+		t.lru.MoveToFront(e)
+		return
+	}
+	t.entries[key] = t.lru.PushFront(entry)
+
+	maxEntries := t.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = DefaultConditionalCacheSize
+	}
+	for t.lru.Len() > maxEntries {
+		oldest := t.lru.Back()
+		t.lru.Remove(oldest)
+		delete(t.entries, oldest.Value.(conditionalEntry).key)
+	}
+}
+
+// conditionalCacheKey returns the cache key of the request.
+//
+// NOTE: The API token is hashed so it isn't retained in memory in plain text.
+func conditionalCacheKey(req *http.Request) string {
+	token := sha256.Sum256([]byte(req.Header.Get("Fastly-Key")))
+	return req.Method + " " + req.URL.String() + "\n" + req.Header.Get("Accept") + "\n" + hex.EncodeToString(token[:])
+}
+
+// ReadConditional calls read with a context that enables conditional requests
+// (see ConditionalTransport). If the API reports the response hasn't changed
+// since it was last read, then the value decoded from the earlier response is
+// returned instead.
+//
+// NOTE: The returned value might be shared with other callers, so it must not
+// be mutated.
+func ReadConditional[T any](api API, read func(ctx context.Context) (T, *http.Response, error)) (T, *http.Response, error) {
+	cr := &conditionalRequest{}
+	value, httpResp, err := read(context.WithValue(api.ClientCtx, conditionalContextKey{}, cr))
+	if err != nil || cr.transport == nil {
+		return value, httpResp, err
+	}
+
+	if cr.notModified {
+		if cached, ok := cr.value.(T); ok {
+			return cached, httpResp, nil
+		}
+		// The cached value was decoded into a different type (which isn't
+		// expected), so the response has to be requested again.
+		return read(api.ClientCtx)
+	}
+
+	if cr.etag != "" {
+		cr.transport.set(cr.key, cr.etag, value)
+	}
+
+	return value, httpResp, nil
+}
@@ -0,0 +1,108 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fastly/fastly-go/fastly"
+)
+
+// newConditionalAPI returns an API whose client uses a ConditionalTransport,
+// and a server that responds to /service/{id}/version/1/domain with an ETag.
+func newConditionalAPI(t *testing.T, maxEntries int) (API, *ConditionalTransport, *int) {
+	t.Helper()
+
+	var bodies int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		etag := `"` + r.URL.Path + `"`
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		bodies++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", etag)
+		fmt.Fprint(w, `[{"name":"example.com"}]`)
+	}))
+	t.Cleanup(server.Close)
+
+	transport := NewConditionalTransport(server.Client().Transport)
+	transport.MaxEntries = maxEntries
+
+	cfg := fastly.NewConfiguration()
+	cfg.HTTPClient = &http.Client{Transport: transport}
+	cfg.Servers = fastly.ServerConfigurations{{URL: server.URL}}
+	for op := range cfg.OperationServers {
+		cfg.OperationServers[op] = fastly.ServerConfigurations{{URL: server.URL}}
+	}
+
+	api := API{
+		Client:    fastly.NewAPIClient(cfg),
+		ClientCtx: context.WithValue(context.Background(), fastly.ContextAPIKeys, map[string]fastly.APIKey{"token": {Key: "a"}}),
+	}
+	return api, transport, &bodies
+}
+
+func listDomains(t *testing.T, api API, serviceID string) []fastly.DomainResponse {
+	t.Helper()
+
+	clientResp, httpResp, err := ReadConditional(api, func(clientCtx context.Context) ([]fastly.DomainResponse, *http.Response, error) {
+		return api.Client.DomainAPI.ListDomains(clientCtx, serviceID, 1).Execute()
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	httpResp.Body.Close()
+	return clientResp
+}
+
+func TestConditionalTransport(t *testing.T) {
+	api, transport, bodies := newConditionalAPI(t, DefaultConditionalCacheSize)
+
+	for i := 0; i < 2; i++ {
+		domains := listDomains(t, api, "123")
+		if len(domains) != 1 || domains[0].GetName() != "example.com" {
+			t.Fatalf("want the decoded domains, got: %+v", domains)
+		}
+	}
+	if *bodies != 1 {
+		t.Errorf("want one response body, got: %d", *bodies)
+	}
+	if hits, misses := transport.Stats(); hits != 1 || misses != 1 {
+		t.Errorf("want one hit and one miss, got: %d hits, %d misses", hits, misses)
+	}
+
+	// A request with a different token isn't revalidated.
+	other := api
+	other.ClientCtx = context.WithValue(context.Background(), fastly.ContextAPIKeys, map[string]fastly.APIKey{"token": {Key: "b"}})
+	listDomains(t, other, "123")
+	if *bodies != 2 {
+		t.Errorf("want another token to fetch the response body, got: %d", *bodies)
+	}
+
+	// A request that doesn't use ReadConditional isn't revalidated.
+	_, httpResp, err := api.Client.DomainAPI.ListDomains(api.ClientCtx, "123", 1).Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	httpResp.Body.Close()
+	if *bodies != 3 {
+		t.Errorf("want an unconditional request to fetch the response body, got: %d", *bodies)
+	}
+}
+
+func TestConditionalTransportEviction(t *testing.T) {
+	api, _, bodies := newConditionalAPI(t, 1)
+
+	listDomains(t, api, "123")
+	listDomains(t, api, "456") // evicts service 123
+	listDomains(t, api, "123")
+	listDomains(t, api, "123")
+
+	if *bodies != 3 {
+		t.Errorf("want the least recently used response to be evicted, got %d response bodies", *bodies)
+	}
+}
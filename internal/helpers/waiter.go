@@ -0,0 +1,102 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrWaitTimeout is returned by Waiter.Wait when an operation hasn't completed
+// before the timeout elapses (or the maximum number of attempts is reached).
+var ErrWaitTimeout = errors.New("timed out waiting for the operation to complete")
+
+// Waiter polls an asynchronous operation (e.g. a service version activation)
+// until it completes.
+//
+// The delay before the first retry is Interval. The delay is multiplied by
+// Multiplier after each attempt (up to MaxInterval).
+type Waiter struct {
+	// Interval is the delay before the first retry.
+	Interval time.Duration
+	// MaxAttempts is the maximum number of attempts (zero means no limit).
+	MaxAttempts int
+	// MaxInterval is the maximum delay between attempts (zero means no limit).
+	MaxInterval time.Duration
+	// Multiplier is the factor the delay grows by after each attempt.
+	// A value of one (or less) polls at a constant Interval.
+	Multiplier float64
+	// Sleep waits for the delay before the next attempt, and returns false if
+	// the context is done. It can be set to wake up early when an operation is
+	// known to have progressed (see WaitForDomainRelease).
+	Sleep func(ctx context.Context, delay time.Duration) bool
+	// Timeout is the maximum duration to wait for (zero means no limit).
+	Timeout time.Duration
+}
+
+// Delay returns the delay after the given attempt (starting at one).
+func (w Waiter) Delay(attempt int) time.Duration {
+	delay := w.Interval
+	for i := 1; i < attempt && w.Multiplier > 1; i++ {
+		next := time.Duration(float64(delay) * w.Multiplier)
+		if next <= delay || (w.MaxInterval > 0 && next >= w.MaxInterval) {
+			delay = max(delay, w.MaxInterval)
+			break
+		}
+		delay = next
+	}
+	if w.MaxInterval > 0 && delay > w.MaxInterval {
+		delay = w.MaxInterval
+	}
+	return delay
+}
+
+// Wait calls poll until it reports the operation is done or returns an error
+// (which is returned as-is).
+//
+// ErrWaitTimeout is returned if the next attempt would exceed MaxAttempts or
+// start after the Timeout has elapsed, and the context error is returned if
+// ctx is done while waiting.
+func (w Waiter) Wait(ctx context.Context, poll func(attempt int) (done bool, err error)) error {
+	sleep := w.Sleep
+	if sleep == nil {
+		sleep = sleepContext
+	}
+
+	var deadline time.Time
+	if w.Timeout > 0 {
+		deadline = time.Now().Add(w.Timeout)
+	}
+
+	for attempt := 1; ; attempt++ {
+		done, err := poll(attempt)
+		if err != nil || done {
+			return err
+		}
+
+		delay := w.Delay(attempt)
+		if w.MaxAttempts > 0 && attempt >= w.MaxAttempts {
+			return ErrWaitTimeout
+		}
+		if !deadline.IsZero() && time.Now().Add(delay).After(deadline) {
+			return ErrWaitTimeout
+		}
+
+		if !sleep(ctx, delay) {
+			return ctx.Err()
+		}
+	}
+}
+
+// sleepContext blocks until the delay has elapsed or the context is done.
+// It returns false only if the context is done.
+func sleepContext(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
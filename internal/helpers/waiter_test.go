@@ -0,0 +1,81 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaiterDelay(t *testing.T) {
+	w := Waiter{Interval: time.Second, MaxInterval: 5 * time.Second, Multiplier: 2}
+	for attempt, want := range map[int]time.Duration{
+		1:    time.Second,
+		2:    2 * time.Second,
+		3:    4 * time.Second,
+		4:    5 * time.Second,
+		1000: 5 * time.Second,
+	} {
+		if got := w.Delay(attempt); got != want {
+			t.Errorf("Delay(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+
+	constant := Waiter{Interval: time.Second}
+	if got := constant.Delay(10); got != time.Second {
+		t.Errorf("want a constant delay without a multiplier, got: %s", got)
+	}
+}
+
+func TestWaiterWait(t *testing.T) {
+	w := Waiter{Interval: time.Millisecond, Multiplier: 2, MaxAttempts: 5}
+
+	var attempts int
+	err := w.Wait(context.Background(), func(attempt int) (bool, error) {
+		attempts = attempt
+		return attempt == 3, nil
+	})
+	if err != nil || attempts != 3 {
+		t.Errorf("want success after 3 attempts, got %d attempts: %v", attempts, err)
+	}
+
+	err = w.Wait(context.Background(), func(attempt int) (bool, error) {
+		attempts = attempt
+		return false, nil
+	})
+	if !errors.Is(err, ErrWaitTimeout) || attempts != 5 {
+		t.Errorf("want a timeout after 5 attempts, got %d attempts: %v", attempts, err)
+	}
+
+	pollErr := errors.New("failed")
+	err = w.Wait(context.Background(), func(int) (bool, error) {
+		return false, pollErr
+	})
+	if !errors.Is(err, pollErr) {
+		t.Errorf("want the poll error, got: %v", err)
+	}
+}
+
+func TestWaiterWaitTimeout(t *testing.T) {
+	w := Waiter{Interval: time.Hour, Timeout: time.Minute}
+
+	err := w.Wait(context.Background(), func(int) (bool, error) {
+		return false, nil
+	})
+	if !errors.Is(err, ErrWaitTimeout) {
+		t.Errorf("want a timeout rather than a delay beyond the deadline, got: %v", err)
+	}
+}
+
+func TestWaiterWaitCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	w := Waiter{Interval: time.Hour}
+	err := w.Wait(ctx, func(int) (bool, error) {
+		return false, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("want the context error, got: %v", err)
+	}
+}
@@ -0,0 +1,2 @@
+// Package mockapi implements an in-memory mock of the Fastly API for tests.
+package mockapi
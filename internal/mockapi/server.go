@@ -0,0 +1,940 @@
+package mockapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fastly/fastly-go/fastly"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// Server is an in-memory implementation of the Fastly API endpoints used by
+// the provider (services, versions, dictionaries, domains, logging endpoints,
+// settings, HTTP/3, generated VCL, product enablements, real-time stats and KV
+// store entries).
+//
+// Every request is recorded so tests can validate the request shapes.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	failures map[string][]int
+	kv       map[string]map[string]*KVEntry
+	nextID   int
+	requests []Request
+	services map[string]*Service
+}
+
+// KVEntry is a KV store entry stored by the Server.
+type KVEntry struct {
+	Generation int64
+	Metadata   string
+	Value      string
+}
+
+// Request is a request received by the Server.
+type Request struct {
+	// Form is the decoded form body.
+	Form url.Values
+	// Method is the HTTP method.
+	Method string
+	// Path is the URL path.
+	Path string
+}
+
+// Service is a Fastly service stored by the Server.
+type Service struct {
+	Comment   string
+	DeletedAt *time.Time
+	ID        string
+	Name      string
+	// Products are the IDs of the enabled products.
+	Products map[string]bool
+	// Traffic is the requests per second reported by the real-time stats.
+	Traffic  int32
+	Type     string
+	Versions []*Version
+}
+
+// Version is a Fastly service version stored by the Server.
+type Version struct {
+	Active       bool
+	Dictionaries []Dictionary
+	Domains      []Domain
+	// HTTP3 indicates if HTTP/3 is enabled for the version.
+	HTTP3  bool
+	Locked bool
+	// Logging are the logging endpoints, keyed by the endpoint type (e.g. kafka).
+	Logging  map[string][]LoggingEndpoint
+	Number   int32
+	Settings Settings
+}
+
+// LoggingEndpoint is a Fastly logging endpoint stored by the Server, as the
+// form attributes it was created (or updated) with.
+type LoggingEndpoint map[string]string
+
+// loggingIntFields and loggingBoolFields are the logging endpoint attributes
+// that aren't returned by the API as strings.
+var (
+	loggingIntFields  = map[string]bool{"request_max_bytes": true, "required_acks": true, "use_tls": true}
+	loggingBoolFields = map[string]bool{"parse_log_keyvals": true}
+)
+
+// Dictionary is a Fastly edge dictionary stored by the Server.
+//
+// NOTE: The ID is kept when a version is cloned (or the dictionary renamed).
+type Dictionary struct {
+	ID        string
+	Name      string
+	WriteOnly bool
+}
+
+// Domain is a Fastly domain stored by the Server.
+type Domain struct {
+	Comment string
+	Name    string
+}
+
+// Settings are the Fastly service version settings stored by the Server.
+type Settings struct {
+	DefaultHost     string
+	DefaultTTL      int32
+	StaleIfError    bool
+	StaleIfErrorTTL int32
+}
+
+// NewServer starts and returns a new Server.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		failures: map[string][]int{},
+		kv:       map[string]map[string]*KVEntry{},
+		services: map[string]*Service{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Client returns a Fastly API client that sends all requests to the Server.
+func (s *Server) Client() *fastly.APIClient {
+	cfg := fastly.NewConfiguration()
+	cfg.HTTPClient = s.Server.Client()
+	cfg.Servers = fastly.ServerConfigurations{{URL: s.URL}}
+	for op := range cfg.OperationServers {
+		cfg.OperationServers[op] = fastly.ServerConfigurations{{URL: s.URL}}
+	}
+	return fastly.NewAPIClient(cfg)
+}
+
+// NewService starts a new Server (shut down when the test finishes) with a
+// single VCL service named `test`. It returns the Server, an API helper that
+// sends all requests to the Server, and the ID of the service.
+func NewService(t testing.TB) (*Server, helpers.API, string) {
+	t.Helper()
+
+	server := NewServer()
+	t.Cleanup(server.Close)
+
+	api := helpers.API{
+		Client:    server.Client(),
+		ClientCtx: context.Background(),
+	}
+
+	return server, api, server.AddService(t, "test")
+}
+
+// AddService creates a VCL service with the given name, using the API, and
+// returns the ID of the service.
+func (s *Server) AddService(t testing.TB, name string) string {
+	t.Helper()
+
+	client := s.Client()
+	clientReq := client.ServiceAPI.CreateService(context.Background())
+	clientReq.Name(name)
+	clientResp, httpResp, err := clientReq.Execute()
+	if err != nil {
+		t.Fatalf("failed to create mock service: %s", err)
+	}
+	httpResp.Body.Close()
+
+	return clientResp.GetID()
+}
+
+// FailNext causes the next request matching the method and path to fail with
+// the given status code. Multiple failures for the same request are queued.
+func (s *Server) FailNext(method, path string, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := method + " " + path
+	s.failures[key] = append(s.failures[key], status)
+}
+
+// Requests returns all the requests received by the Server.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// KVEntry returns a copy of the stored KV store entry, or nil if it doesn't exist.
+func (s *Server) KVEntry(storeID, key string) *KVEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.kv[storeID][key]
+	if !ok {
+		return nil
+	}
+	c := *entry
+	return &c
+}
+
+// SetKVEntry stores the KV store entry value (e.g. a write by another actor),
+// incrementing the entry's generation.
+func (s *Server) SetKVEntry(storeID, key, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.putKVEntry(storeID, key, value, "")
+}
+
+// SetTraffic sets the requests per second reported by the real-time stats for
+// the stored service.
+func (s *Server) SetTraffic(id string, requests int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if svc, ok := s.services[id]; ok {
+		svc.Traffic = requests
+	}
+}
+
+// Service returns a copy of the stored service, or nil if it doesn't exist.
+func (s *Server) Service(id string) *Service {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	svc, ok := s.services[id]
+	if !ok {
+		return nil
+	}
+	c := *svc
+	c.Products = make(map[string]bool, len(svc.Products))
+	for id, enabled := range svc.Products {
+		c.Products[id] = enabled
+	}
+	c.Versions = make([]*Version, 0, len(svc.Versions))
+	for _, v := range svc.Versions {
+		vc := *v
+		vc.Dictionaries = append([]Dictionary(nil), v.Dictionaries...)
+		vc.Domains = append([]Domain(nil), v.Domains...)
+		vc.Logging = copyLogging(v.Logging)
+		c.Versions = append(c.Versions, &vc)
+	}
+	return &c
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	form, _ := url.ParseQuery(string(body))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, Request{Form: form, Method: r.Method, Path: r.URL.Path})
+
+	key := r.Method + " " + r.URL.Path
+	if queued := s.failures[key]; len(queued) > 0 {
+		s.failures[key] = queued[1:]
+		writeError(w, queued[0])
+		return
+	}
+
+	// e.g. /service/{service_id}/version/{version_id}/domain/{domain_name}
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	// e.g. /v1/channel/{service_id}/ts/h
+	if len(segments) == 5 && segments[0] == "v1" && segments[1] == "channel" && r.Method == http.MethodGet {
+		s.realtimeStats(w, segments[2])
+		return
+	}
+
+	// e.g. /resources/stores/kv/{store_id}/keys/{key_name}
+	// NOTE: The escaped path is used as the key name may contain a slash.
+	if escaped := strings.Split(strings.Trim(r.URL.EscapedPath(), "/"), "/"); len(escaped) == 6 && strings.Join(escaped[:3], "/") == "resources/stores/kv" && escaped[4] == "keys" {
+		key, _ := url.PathUnescape(escaped[5])
+		s.handleKVEntry(w, r, escaped[3], key, string(body))
+		return
+	}
+
+	// e.g. /enabled-products/{product_id}/services/{service_id}
+	if len(segments) == 4 && segments[0] == "enabled-products" && segments[2] == "services" {
+		s.handleProduct(w, r.Method, segments[1], segments[3])
+		return
+	}
+
+	if len(segments) == 0 || segments[0] != "service" {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+
+	if len(segments) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listServices(w)
+		case http.MethodPost:
+			s.createService(w, form)
+		default:
+			writeError(w, http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	svc, ok := s.services[segments[1]]
+	if !ok {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case len(segments) == 2:
+		s.handleService(w, r.Method, svc, form)
+	case len(segments) == 3 && segments[2] == "details" && r.Method == http.MethodGet:
+		writeJSON(w, serviceDetailJSON(svc))
+	case len(segments) >= 4 && segments[2] == "version":
+		n, err := strconv.Atoi(segments[3])
+		if err != nil || n < 1 || n > len(svc.Versions) {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		s.handleVersion(w, r.Method, svc, svc.Versions[n-1], segments[4:], form)
+	default:
+		writeError(w, http.StatusNotFound)
+	}
+}
+
+// realtimeStats writes the real-time stats for the last 120 seconds, with each
+// second reporting the service's Traffic.
+func (s *Server) realtimeStats(w http.ResponseWriter, serviceID string) {
+	svc, ok := s.services[serviceID]
+	if !ok {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+	data := make([]map[string]any, 0, 120)
+	for i := 0; i < 120; i++ {
+		data = append(data, map[string]any{
+			"aggregated": map[string]any{"requests": svc.Traffic},
+		})
+	}
+	writeJSON(w, map[string]any{
+		"AggregateDelay": 0,
+		"Data":           data,
+		"Timestamp":      time.Now().Unix(),
+	})
+}
+
+// handleKVEntry handles the KV store entry endpoints, including the `add`
+// query parameter and the `if-generation-match` header (conditional writes).
+func (s *Server) handleKVEntry(w http.ResponseWriter, r *http.Request, storeID, key, body string) {
+	entry, exists := s.kv[storeID][key]
+
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Generation", strconv.FormatInt(entry.Generation, 10))
+		if entry.Metadata != "" {
+			w.Header().Set("Metadata", entry.Metadata)
+		}
+		_, _ = io.WriteString(w, entry.Value)
+	case http.MethodPut:
+		if exists && r.URL.Query().Get("add") == "true" {
+			writeError(w, http.StatusPreconditionFailed)
+			return
+		}
+		if match := r.Header.Get("If-Generation-Match"); match != "" && (!exists || match != strconv.FormatInt(entry.Generation, 10)) {
+			writeError(w, http.StatusPreconditionFailed)
+			return
+		}
+		entry = s.putKVEntry(storeID, key, body, r.Header.Get("Metadata"))
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Generation", strconv.FormatInt(entry.Generation, 10))
+	case http.MethodDelete:
+		if !exists {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		delete(s.kv[storeID], key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed)
+	}
+}
+
+// putKVEntry stores the KV store entry, incrementing its generation.
+func (s *Server) putKVEntry(storeID, key, value, metadata string) *KVEntry {
+	if s.kv[storeID] == nil {
+		s.kv[storeID] = map[string]*KVEntry{}
+	}
+	var generation int64
+	if entry, ok := s.kv[storeID][key]; ok {
+		generation = entry.Generation
+	}
+	entry := &KVEntry{Generation: generation + 1, Metadata: metadata, Value: value}
+	s.kv[storeID][key] = entry
+	return entry
+}
+
+func (s *Server) listServices(w http.ResponseWriter) {
+	list := make([]map[string]any, 0, len(s.services))
+	for _, svc := range s.services {
+		list = append(list, serviceJSON(svc))
+	}
+	writeJSON(w, list)
+}
+
+func (s *Server) createService(w http.ResponseWriter, form url.Values) {
+	s.nextID++
+	svc := &Service{
+		Comment: form.Get("comment"),
+		ID:      fmt.Sprintf("service%d", s.nextID),
+		Name:    form.Get("name"),
+		Type:    form.Get("type"),
+		Versions: []*Version{{
+			Number:   1,
+			Settings: Settings{DefaultTTL: 3600, StaleIfErrorTTL: 43200},
+		}},
+	}
+	if svc.Type == "" {
+		svc.Type = "vcl"
+	}
+	s.services[svc.ID] = svc
+	writeJSON(w, serviceJSON(svc))
+}
+
+func (s *Server) handleService(w http.ResponseWriter, method string, svc *Service, form url.Values) {
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, serviceJSON(svc))
+	case http.MethodPut:
+		if form.Has("comment") {
+			svc.Comment = form.Get("comment")
+		}
+		if form.Has("name") {
+			svc.Name = form.Get("name")
+		}
+		writeJSON(w, serviceJSON(svc))
+	case http.MethodDelete:
+		for _, v := range svc.Versions {
+			if v.Active {
+				writeError(w, http.StatusBadRequest)
+				return
+			}
+		}
+		now := time.Now().UTC()
+		svc.DeletedAt = &now
+		writeJSON(w, map[string]any{"status": "ok"})
+	default:
+		writeError(w, http.StatusMethodNotAllowed)
+	}
+}
+
+// handleProduct mimics the product enablement API, which returns a 404 for a
+// product that isn't enabled.
+func (s *Server) handleProduct(w http.ResponseWriter, method, productID, serviceID string) {
+	svc, ok := s.services[serviceID]
+	if !ok {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+
+	data := map[string]any{
+		"product": map[string]any{"id": productID, "object": "product"},
+		"service": map[string]any{"id": serviceID, "object": "service"},
+	}
+
+	switch method {
+	case http.MethodGet:
+		if !svc.Products[productID] {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		writeJSON(w, data)
+	case http.MethodPut:
+		if svc.Products == nil {
+			svc.Products = map[string]bool{}
+		}
+		svc.Products[productID] = true
+		writeJSON(w, data)
+	case http.MethodDelete:
+		if !svc.Products[productID] {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		delete(svc.Products, productID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleVersion(w http.ResponseWriter, method string, svc *Service, v *Version, segments []string, form url.Values) {
+	switch {
+	case len(segments) == 0 && method == http.MethodGet:
+		writeJSON(w, versionJSON(svc, v))
+	case len(segments) == 1 && method == http.MethodPut:
+		switch segments[0] {
+		case "activate":
+			for _, other := range svc.Versions {
+				other.Active = false
+			}
+			v.Active = true
+			v.Locked = true
+		case "clone":
+			clone := &Version{
+				Dictionaries: append([]Dictionary(nil), v.Dictionaries...),
+				Domains:      append([]Domain(nil), v.Domains...),
+				HTTP3:        v.HTTP3,
+				Logging:      copyLogging(v.Logging),
+				Number:       int32(len(svc.Versions) + 1),
+				Settings:     v.Settings,
+			}
+			svc.Versions = append(svc.Versions, clone)
+			v = clone
+		case "deactivate":
+			v.Active = false
+		case "lock":
+			v.Locked = true
+		case "settings":
+			s.updateSettings(w, svc, v, form)
+			return
+		default:
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		writeJSON(w, versionJSON(svc, v))
+	case len(segments) == 1 && segments[0] == "generated_vcl" && method == http.MethodGet:
+		writeJSON(w, map[string]any{
+			"content":    fmt.Sprintf("# generated VCL for version %d", v.Number),
+			"main":       false,
+			"name":       "generated",
+			"service_id": svc.ID,
+			"version":    v.Number,
+		})
+	case len(segments) == 1 && segments[0] == "http3":
+		s.handleHTTP3(w, method, svc, v)
+	case len(segments) == 1 && segments[0] == "settings" && method == http.MethodGet:
+		writeJSON(w, settingsJSON(svc, v))
+	case len(segments) == 1 && segments[0] == "validate" && method == http.MethodGet:
+		writeJSON(w, map[string]any{"status": "ok"})
+	case len(segments) >= 1 && segments[0] == "dictionary":
+		s.handleDictionary(w, method, svc, v, segments[1:], form)
+	case len(segments) >= 1 && segments[0] == "domain":
+		s.handleDomain(w, method, svc, v, segments[1:], form)
+	case len(segments) >= 2 && segments[0] == "logging":
+		s.handleLogging(w, method, svc, v, segments[1], segments[2:], form)
+	default:
+		writeError(w, http.StatusNotFound)
+	}
+}
+
+// handleHTTP3 mimics the HTTP/3 API, which returns a 404 if HTTP/3 isn't
+// enabled for the version.
+func (s *Server) handleHTTP3(w http.ResponseWriter, method string, svc *Service, v *Version) {
+	data := map[string]any{
+		"feature_revision": 1,
+		"service_id":       svc.ID,
+		"version":          v.Number,
+	}
+
+	switch method {
+	case http.MethodGet:
+		if !v.HTTP3 {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		writeJSON(w, data)
+	case http.MethodPost:
+		if !editable(w, v) {
+			return
+		}
+		v.HTTP3 = true
+		writeJSON(w, data)
+	case http.MethodDelete:
+		if !editable(w, v) {
+			return
+		}
+		if !v.HTTP3 {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		v.HTTP3 = false
+		writeJSON(w, map[string]any{"status": "ok"})
+	default:
+		writeError(w, http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) updateSettings(w http.ResponseWriter, svc *Service, v *Version, form url.Values) {
+	if !editable(w, v) {
+		return
+	}
+	if form.Has("general.default_host") {
+		v.Settings.DefaultHost = form.Get("general.default_host")
+	}
+	if form.Has("general.default_ttl") {
+		n, _ := strconv.Atoi(form.Get("general.default_ttl"))
+		v.Settings.DefaultTTL = int32(n)
+	}
+	if form.Has("general.stale_if_error") {
+		v.Settings.StaleIfError, _ = strconv.ParseBool(form.Get("general.stale_if_error"))
+	}
+	if form.Has("general.stale_if_error_ttl") {
+		n, _ := strconv.Atoi(form.Get("general.stale_if_error_ttl"))
+		v.Settings.StaleIfErrorTTL = int32(n)
+	}
+	writeJSON(w, settingsJSON(svc, v))
+}
+
+func (s *Server) handleDomain(w http.ResponseWriter, method string, svc *Service, v *Version, segments []string, form url.Values) {
+	if len(segments) == 0 {
+		switch method {
+		case http.MethodGet:
+			list := make([]map[string]any, 0, len(v.Domains))
+			for _, d := range v.Domains {
+				list = append(list, domainJSON(svc, v, d))
+			}
+			writeJSON(w, list)
+		case http.MethodPost:
+			if !editable(w, v) {
+				return
+			}
+			for _, d := range v.Domains {
+				if d.Name == form.Get("name") {
+					writeError(w, http.StatusConflict)
+					return
+				}
+			}
+			d := Domain{Comment: form.Get("comment"), Name: form.Get("name")}
+			v.Domains = append(v.Domains, d)
+			writeJSON(w, domainJSON(svc, v, d))
+		default:
+			writeError(w, http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	i := -1
+	for j, d := range v.Domains {
+		if d.Name == segments[0] {
+			i = j
+		}
+	}
+	if len(segments) != 1 || i < 0 {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, domainJSON(svc, v, v.Domains[i]))
+	case http.MethodPut:
+		if !editable(w, v) {
+			return
+		}
+		if form.Has("comment") {
+			v.Domains[i].Comment = form.Get("comment")
+		}
+		if form.Has("name") {
+			v.Domains[i].Name = form.Get("name")
+		}
+		writeJSON(w, domainJSON(svc, v, v.Domains[i]))
+	case http.MethodDelete:
+		if !editable(w, v) {
+			return
+		}
+		v.Domains = append(v.Domains[:i], v.Domains[i+1:]...)
+		writeJSON(w, map[string]any{"status": "ok"})
+	default:
+		writeError(w, http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleDictionary(w http.ResponseWriter, method string, svc *Service, v *Version, segments []string, form url.Values) {
+	if len(segments) == 0 {
+		switch method {
+		case http.MethodGet:
+			list := make([]map[string]any, 0, len(v.Dictionaries))
+			for _, d := range v.Dictionaries {
+				list = append(list, dictionaryJSON(svc, v, d))
+			}
+			writeJSON(w, list)
+		case http.MethodPost:
+			if !editable(w, v) {
+				return
+			}
+			for _, d := range v.Dictionaries {
+				if d.Name == form.Get("name") {
+					writeError(w, http.StatusConflict)
+					return
+				}
+			}
+			writeOnly, _ := strconv.ParseBool(form.Get("write_only"))
+			s.nextID++
+			d := Dictionary{ID: fmt.Sprintf("dictionary%d", s.nextID), Name: form.Get("name"), WriteOnly: writeOnly}
+			v.Dictionaries = append(v.Dictionaries, d)
+			writeJSON(w, dictionaryJSON(svc, v, d))
+		default:
+			writeError(w, http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	i := -1
+	for j, d := range v.Dictionaries {
+		if d.Name == segments[0] {
+			i = j
+		}
+	}
+	if len(segments) != 1 || i < 0 {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, dictionaryJSON(svc, v, v.Dictionaries[i]))
+	case http.MethodPut:
+		if !editable(w, v) {
+			return
+		}
+		if form.Has("name") {
+			v.Dictionaries[i].Name = form.Get("name")
+		}
+		// NOTE: Mimics the API rejecting a change to write_only.
+		if form.Has("write_only") {
+			if writeOnly, _ := strconv.ParseBool(form.Get("write_only")); writeOnly != v.Dictionaries[i].WriteOnly {
+				writeError(w, http.StatusBadRequest)
+				return
+			}
+		}
+		writeJSON(w, dictionaryJSON(svc, v, v.Dictionaries[i]))
+	case http.MethodDelete:
+		if !editable(w, v) {
+			return
+		}
+		v.Dictionaries = append(v.Dictionaries[:i], v.Dictionaries[i+1:]...)
+		writeJSON(w, map[string]any{"status": "ok"})
+	default:
+		writeError(w, http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleLogging(w http.ResponseWriter, method string, svc *Service, v *Version, endpointType string, segments []string, form url.Values) {
+	if v.Logging == nil {
+		v.Logging = map[string][]LoggingEndpoint{}
+	}
+	endpoints := v.Logging[endpointType]
+
+	if len(segments) == 0 {
+		switch method {
+		case http.MethodGet:
+			list := make([]map[string]any, 0, len(endpoints))
+			for _, e := range endpoints {
+				list = append(list, loggingJSON(svc, e))
+			}
+			writeJSON(w, list)
+		case http.MethodPost:
+			if !editable(w, v) {
+				return
+			}
+			for _, e := range endpoints {
+				if e["name"] == form.Get("name") {
+					writeError(w, http.StatusConflict)
+					return
+				}
+			}
+			e := LoggingEndpoint{}
+			for k := range form {
+				e[k] = form.Get(k)
+			}
+			v.Logging[endpointType] = append(endpoints, e)
+			writeJSON(w, loggingJSON(svc, e))
+		default:
+			writeError(w, http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	i := -1
+	for j, e := range endpoints {
+		if e["name"] == segments[0] {
+			i = j
+		}
+	}
+	if len(segments) != 1 || i < 0 {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, loggingJSON(svc, endpoints[i]))
+	case http.MethodPut:
+		if !editable(w, v) {
+			return
+		}
+		for k := range form {
+			endpoints[i][k] = form.Get(k)
+		}
+		writeJSON(w, loggingJSON(svc, endpoints[i]))
+	case http.MethodDelete:
+		if !editable(w, v) {
+			return
+		}
+		v.Logging[endpointType] = append(endpoints[:i], endpoints[i+1:]...)
+		writeJSON(w, map[string]any{"status": "ok"})
+	default:
+		writeError(w, http.StatusMethodNotAllowed)
+	}
+}
+
+// copyLogging returns a deep copy of the logging endpoints.
+func copyLogging(logging map[string][]LoggingEndpoint) map[string][]LoggingEndpoint {
+	c := make(map[string][]LoggingEndpoint, len(logging))
+	for endpointType, endpoints := range logging {
+		for _, e := range endpoints {
+			ec := make(LoggingEndpoint, len(e))
+			for k, v := range e {
+				ec[k] = v
+			}
+			c[endpointType] = append(c[endpointType], ec)
+		}
+	}
+	return c
+}
+
+// editable mimics the API rejecting changes to an active or locked version.
+func editable(w http.ResponseWriter, v *Version) bool {
+	if v.Active || v.Locked {
+		writeError(w, http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func serviceJSON(svc *Service) map[string]any {
+	versions := make([]map[string]any, 0, len(svc.Versions))
+	var activeVersion int32
+	for _, v := range svc.Versions {
+		versions = append(versions, versionJSON(svc, v))
+		if v.Active {
+			activeVersion = v.Number
+		}
+	}
+	data := map[string]any{
+		"comment":  svc.Comment,
+		"id":       svc.ID,
+		"name":     svc.Name,
+		"type":     svc.Type,
+		"version":  activeVersion,
+		"versions": versions,
+	}
+	if svc.DeletedAt != nil {
+		data["deleted_at"] = svc.DeletedAt.Format(time.RFC3339)
+	}
+	return data
+}
+
+func serviceDetailJSON(svc *Service) map[string]any {
+	data := serviceJSON(svc)
+	latest := svc.Versions[len(svc.Versions)-1]
+	data["version"] = versionJSON(svc, latest)
+	data["active_version"] = nil
+	for _, v := range svc.Versions {
+		if v.Active {
+			data["active_version"] = versionJSON(svc, v)
+		}
+	}
+	return data
+}
+
+func versionJSON(svc *Service, v *Version) map[string]any {
+	return map[string]any{
+		"active":     v.Active,
+		"locked":     v.Locked,
+		"number":     v.Number,
+		"service_id": svc.ID,
+	}
+}
+
+func domainJSON(svc *Service, v *Version, d Domain) map[string]any {
+	return map[string]any{
+		"comment":    d.Comment,
+		"name":       d.Name,
+		"service_id": svc.ID,
+		"version":    v.Number,
+	}
+}
+
+func dictionaryJSON(svc *Service, v *Version, d Dictionary) map[string]any {
+	return map[string]any{
+		"id":         d.ID,
+		"name":       d.Name,
+		"service_id": svc.ID,
+		"version":    v.Number,
+		"write_only": d.WriteOnly,
+	}
+}
+
+func loggingJSON(svc *Service, e LoggingEndpoint) map[string]any {
+	data := map[string]any{"service_id": svc.ID}
+	for k, v := range e {
+		switch {
+		case loggingIntFields[k]:
+			n, _ := strconv.Atoi(v)
+			data[k] = n
+		case loggingBoolFields[k]:
+			data[k], _ = strconv.ParseBool(v)
+		default:
+			data[k] = v
+		}
+	}
+	return data
+}
+
+func settingsJSON(svc *Service, v *Version) map[string]any {
+	return map[string]any{
+		"general.default_host":       v.Settings.DefaultHost,
+		"general.default_ttl":        v.Settings.DefaultTTL,
+		"general.stale_if_error":     v.Settings.StaleIfError,
+		"general.stale_if_error_ttl": v.Settings.StaleIfErrorTTL,
+		"service_id":                 svc.ID,
+		"version":                    v.Number,
+	}
+}
+
+func writeJSON(w http.ResponseWriter, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+func writeError(w http.ResponseWriter, status int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"msg": http.StatusText(status),
+	})
+}
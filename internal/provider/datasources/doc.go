@@ -1,2 +1,2 @@
-// Package datasources implements example data sources.
+// Package datasources implements the provider data sources.
 package datasources
@@ -0,0 +1,146 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DynamicSnippet{}
+
+// NewDynamicSnippet returns a new data source for reading a dynamic snippet.
+func NewDynamicSnippet() datasource.DataSource {
+	return &DynamicSnippet{}
+}
+
+// DynamicSnippet defines the data source implementation.
+type DynamicSnippet struct {
+	// client is a preconfigured instance of the Fastly API client.
+	client *fastly.APIClient
+	// clientCtx contains the user's API token.
+	clientCtx context.Context
+	// tracer exports the span of each read (nil if tracing is disabled).
+	tracer *helpers.Tracer
+}
+
+// DynamicSnippetModel describes the data source data model.
+type DynamicSnippetModel struct {
+	// Content is the VCL code of the snippet.
+	Content types.String `tfsdk:"content"`
+	// CreatedAt is when the snippet was created.
+	CreatedAt types.String `tfsdk:"created_at"`
+	// ID is a unique identifier for the data source.
+	ID types.String `tfsdk:"id"`
+	// ServiceID is the ID of the service the snippet belongs to.
+	ServiceID types.String `tfsdk:"service_id"`
+	// SnippetID is the ID of the dynamic snippet.
+	SnippetID types.String `tfsdk:"snippet_id"`
+	// UpdatedAt is when the snippet content was last updated.
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (d *DynamicSnippet) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dynamic_snippet"
+}
+
+func (d *DynamicSnippet) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Use this data source to get the current content of a [dynamic VCL snippet](https://developer.fastly.com/reference/api/vcl-services/snippet/). Dynamic snippets are versionless, so the content reflects any changes made outside of Terraform (e.g. for drift checks, or to reuse the content in another service).",
+
+		Attributes: map[string]schema.Attribute{
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The VCL code of the snippet",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time (RFC 3339) the snippet was created",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "An identifier derived from the service and snippet IDs",
+				Computed:            true,
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service",
+				Required:            true,
+			},
+			"snippet_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the dynamic snippet",
+				Required:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time (RFC 3339) the snippet content was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DynamicSnippet) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*helpers.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+	d.clientCtx = fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv)
+	d.tracer = providerData.Tracer
+}
+
+func (d *DynamicSnippet) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	api, finishSpan := d.tracer.Operation(ctx, helpers.API{Client: d.client, ClientCtx: d.clientCtx}, "fastly_dynamic_snippet.Read")
+	defer finishSpan(&resp.Diagnostics)
+
+	var data DynamicSnippetModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := data.ServiceID.ValueString()
+	snippetID := data.SnippetID.ValueString()
+
+	clientResp, httpResp, err := api.Client.SnippetAPI.GetSnippetDynamic(api.ClientCtx, serviceID, snippetID).Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly SnippetAPI.GetSnippetDynamic error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, fmt.Sprintf("Unable to read dynamic snippet '%s'", snippetID))
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	data.Content = types.StringValue(clientResp.GetContent())
+	data.CreatedAt = helpers.Timestamp(clientResp.CreatedAt)
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", serviceID, snippetID))
+	data.UpdatedAt = helpers.Timestamp(clientResp.UpdatedAt)
+
+	tflog.Trace(ctx, "read dynamic snippet", map[string]any{"service_id": serviceID, "snippet_id": snippetID})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -57,18 +59,18 @@ func (d *Example) Configure(_ context.Context, req datasource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*fastly.APIClient)
+	providerData, ok := req.ProviderData.(*helpers.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *fastly.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = providerData.Client
 }
 
 func (d *Example) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
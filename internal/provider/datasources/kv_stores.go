@@ -0,0 +1,167 @@
+package datasources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &KVStores{}
+
+// NewKVStores returns a new data source for listing KV stores.
+func NewKVStores() datasource.DataSource {
+	return &KVStores{}
+}
+
+// KVStores defines the data source implementation.
+type KVStores struct {
+	// client is a preconfigured instance of the Fastly API client.
+	client *fastly.APIClient
+	// clientCtx contains the user's API token.
+	clientCtx context.Context
+	// tracer exports the span of each read (nil if tracing is disabled).
+	tracer *helpers.Tracer
+}
+
+// KVStoresModel describes the data source data model.
+type KVStoresModel struct {
+	// ID is a hash of the IDs of all the returned stores.
+	ID types.String `tfsdk:"id"`
+	// Stores is the list of KV stores available to the account.
+	Stores []KVStoreModel `tfsdk:"stores"`
+}
+
+// KVStoreModel describes a single KV store.
+type KVStoreModel struct {
+	// ID is the ID of the store.
+	ID types.String `tfsdk:"id"`
+	// Name is a human-readable name for the store.
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *KVStores) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kv_stores"
+}
+
+func (d *KVStores) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Use this data source to get a list of the [Fastly KV stores](https://developer.fastly.com/reference/api/services/resources/kv-store/) available to the account.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "An identifier derived from the IDs of the returned stores",
+				Computed:            true,
+			},
+			"stores": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of KV stores",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the store",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "A human-readable name for the store",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *KVStores) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*helpers.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+	d.clientCtx = fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv)
+	d.tracer = providerData.Tracer
+}
+
+func (d *KVStores) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	api, finishSpan := d.tracer.Operation(ctx, helpers.API{Client: d.client, ClientCtx: d.clientCtx}, "fastly_kv_stores.Read")
+	defer finishSpan(&resp.Diagnostics)
+
+	var data KVStoresModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Stores = []KVStoreModel{}
+
+	var cursor string
+	for {
+		clientReq := api.Client.KvStoreAPI.GetStores(api.ClientCtx)
+		if cursor != "" {
+			clientReq = *clientReq.Cursor(cursor)
+		}
+
+		clientResp, httpResp, err := clientReq.Execute()
+		if err != nil {
+			tflog.Trace(ctx, "Fastly KvStoreAPI.GetStores error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+			helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to list KV stores")
+			return
+		}
+		httpResp.Body.Close()
+		if err := helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics); err != nil {
+			return
+		}
+
+		for _, store := range clientResp.GetData() {
+			data.Stores = append(data.Stores, KVStoreModel{
+				ID:   types.StringValue(store.GetID()),
+				Name: types.StringValue(store.GetName()),
+			})
+		}
+
+		meta := clientResp.GetMeta()
+		cursor = meta.GetNextCursor()
+		if cursor == "" {
+			break
+		}
+	}
+
+	ids := make([]string, 0, len(data.Stores))
+	for _, store := range data.Stores {
+		ids = append(ids, store.ID.ValueString())
+	}
+	sum := sha256.Sum256([]byte(strings.Join(ids, ",")))
+	data.ID = types.StringValue(hex.EncodeToString(sum[:]))
+
+	tflog.Trace(ctx, "read KV stores", map[string]any{"count": len(data.Stores)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
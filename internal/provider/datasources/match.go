@@ -0,0 +1,31 @@
+package datasources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// exactlyOne returns the only match, or adds an error if no (or more than one)
+// entity matches the data source filters.
+func exactlyOne[T any](kind string, matches []T, id func(T) string, diags *diag.Diagnostics) (T, bool) {
+	var zero T
+
+	switch len(matches) {
+	case 0:
+		diags.AddError(helpers.ErrorUser, fmt.Sprintf("No %s matches the given filters", kind))
+		return zero, false
+	case 1:
+		return matches[0], true
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, match := range matches {
+			ids = append(ids, id(match))
+		}
+		diags.AddError(helpers.ErrorUser, fmt.Sprintf("More than one %s matches the given filters (%s), add a filter to select one", kind, strings.Join(ids, ", ")))
+		return zero, false
+	}
+}
@@ -0,0 +1,178 @@
+package datasources
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SecretStoreClientKey{}
+
+// NewSecretStoreClientKey returns a new data source for reading a secret store
+// client key.
+func NewSecretStoreClientKey() datasource.DataSource {
+	return &SecretStoreClientKey{}
+}
+
+// SecretStoreClientKey defines the data source implementation.
+type SecretStoreClientKey struct {
+	// client is a preconfigured instance of the Fastly API client.
+	client *fastly.APIClient
+	// clientCtx contains the user's API token.
+	clientCtx context.Context
+	// tracer exports the span of each read (nil if tracing is disabled).
+	tracer *helpers.Tracer
+}
+
+// SecretStoreClientKeyModel describes the data source data model.
+type SecretStoreClientKeyModel struct {
+	// ClientKey is the Base64-encoded X25519 public key.
+	ClientKey types.String `tfsdk:"client_key"`
+	// ExpiresAt is when the client key expires.
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	// ID is a unique identifier for the data source.
+	ID types.String `tfsdk:"id"`
+	// Signature is the Base64-encoded signature of the client key.
+	Signature types.String `tfsdk:"signature"`
+	// SigningKey is the Base64-encoded Ed25519 public key used to sign the client key.
+	SigningKey types.String `tfsdk:"signing_key"`
+}
+
+func (d *SecretStoreClientKey) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_store_client_key"
+}
+
+func (d *SecretStoreClientKey) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Use this data source to get a [secret store client key](https://developer.fastly.com/reference/api/services/resources/secret-store/), which can be used with a libsodium-compatible sealed box to encrypt secret values client-side before uploading them. The signature of the client key is verified using the signing key. A new client key is returned each time the data source is read, and it expires (see `expires_at`), so it should only be used for an immediate upload.",
+
+		Attributes: map[string]schema.Attribute{
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "The Base64-encoded X25519 public key",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time (RFC 3339) the client key expires",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The client key (as it uniquely identifies the data source)",
+				Computed:            true,
+			},
+			"signature": schema.StringAttribute{
+				MarkdownDescription: "The Base64-encoded signature of the client key, generated using the signing key",
+				Computed:            true,
+			},
+			"signing_key": schema.StringAttribute{
+				MarkdownDescription: "The Base64-encoded Ed25519 public key used to verify the signature of the client key",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SecretStoreClientKey) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*helpers.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+	d.clientCtx = fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv)
+	d.tracer = providerData.Tracer
+}
+
+func (d *SecretStoreClientKey) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	api, finishSpan := d.tracer.Operation(ctx, helpers.API{Client: d.client, ClientCtx: d.clientCtx}, "fastly_secret_store_client_key.Read")
+	defer finishSpan(&resp.Diagnostics)
+
+	var data SecretStoreClientKeyModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	signingResp, httpResp, err := api.Client.SecretStoreAPI.SigningKey(api.ClientCtx).Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly SecretStoreAPI.SigningKey error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to read the secret store signing key")
+		return
+	}
+	defer httpResp.Body.Close()
+
+	clientResp, httpResp, err := api.Client.SecretStoreAPI.ClientKey(api.ClientCtx).Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly SecretStoreAPI.ClientKey error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to create a secret store client key")
+		return
+	}
+	defer httpResp.Body.Close()
+
+	err = verifyClientKey(signingResp.GetSigningKey(), clientResp.GetClientKey(), clientResp.GetSignature())
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ErrorAPI, fmt.Sprintf("Unable to verify the secret store client key, got error: %s", err))
+		return
+	}
+
+	data.ClientKey = types.StringValue(clientResp.GetClientKey())
+	data.ExpiresAt = helpers.Timestamp(clientResp.ExpiresAt)
+	data.ID = data.ClientKey
+	data.Signature = types.StringValue(clientResp.GetSignature())
+	data.SigningKey = types.StringValue(signingResp.GetSigningKey())
+
+	tflog.Trace(ctx, "read secret store client key", map[string]any{"expires_at": data.ExpiresAt.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// verifyClientKey verifies the signature of the client key using the signing
+// key (all Base64-encoded), so a tampered client key is never used to encrypt
+// secrets.
+func verifyClientKey(signingKey, clientKey, signature string) error {
+	pub, err := base64.StdEncoding.DecodeString(signingKey)
+	if err != nil {
+		return fmt.Errorf("invalid signing key: %w", err)
+	}
+	if len(pub) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid signing key: want %d bytes, got %d", ed25519.PublicKeySize, len(pub))
+	}
+	key, err := base64.StdEncoding.DecodeString(clientKey)
+	if err != nil {
+		return fmt.Errorf("invalid client key: %w", err)
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	if !ed25519.Verify(pub, key, sig) {
+		return errors.New("the signature doesn't match the client key")
+	}
+	return nil
+}
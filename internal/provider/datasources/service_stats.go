@@ -0,0 +1,167 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ServiceStats{}
+
+// NewServiceStats returns a new data source for reading the historical stats
+// of a service totalled over a time range.
+func NewServiceStats() datasource.DataSource {
+	return &ServiceStats{}
+}
+
+// ServiceStats defines the data source implementation.
+type ServiceStats struct {
+	// client is a preconfigured instance of the Fastly API client.
+	client *fastly.APIClient
+	// clientCtx contains the user's API token.
+	clientCtx context.Context
+	// tracer exports the span of each read (nil if tracing is disabled).
+	tracer *helpers.Tracer
+}
+
+// ServiceStatsModel describes the data source data model.
+type ServiceStatsModel struct {
+	// Bandwidth is the total bytes delivered.
+	Bandwidth types.Int64 `tfsdk:"bandwidth"`
+	// By is the duration of each sample window used to total the stats.
+	By types.String `tfsdk:"by"`
+	// Errors is the number of cache errors.
+	Errors types.Int64 `tfsdk:"errors"`
+	// From is the start of the window to fetch stats for.
+	From types.String `tfsdk:"from"`
+	// HitRatio is the ratio of cache hits to cache lookups.
+	HitRatio types.Float64 `tfsdk:"hit_ratio"`
+	// Hits is the number of cache hits.
+	Hits types.Int64 `tfsdk:"hits"`
+	// ID is a unique identifier for the data source.
+	ID types.String `tfsdk:"id"`
+	// Miss is the number of cache misses.
+	Miss types.Int64 `tfsdk:"miss"`
+	// Region limits the stats to a specific geographic region.
+	Region types.String `tfsdk:"region"`
+	// Requests is the number of requests processed.
+	Requests types.Int64 `tfsdk:"requests"`
+	// ServiceID is the ID of the service to fetch stats for.
+	ServiceID types.String `tfsdk:"service_id"`
+	// Status4xx is the number of 4xx responses delivered.
+	Status4xx types.Int64 `tfsdk:"status_4xx"`
+	// Status5xx is the number of 5xx responses delivered.
+	Status5xx types.Int64 `tfsdk:"status_5xx"`
+	// To is the end of the window to fetch stats for.
+	To types.String `tfsdk:"to"`
+}
+
+func (d *ServiceStats) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_stats"
+}
+
+func (d *ServiceStats) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := statsQueryAttributes("The duration of each sample window used to total the stats, which determines how the time range is rounded. One of `minute`, `hour` or `day` (the API defaults to `day`)")
+	attrs["bandwidth"] = statsCount("The total bytes delivered")
+	attrs["errors"] = statsCount("The number of cache errors")
+	attrs["hit_ratio"] = schema.Float64Attribute{
+		MarkdownDescription: "The ratio of cache hits to cache lookups (hits and misses), or `0` if there were no lookups",
+		Computed:            true,
+	}
+	attrs["hits"] = statsCount("The number of cache hits")
+	attrs["miss"] = statsCount("The number of cache misses")
+	attrs["requests"] = statsCount("The number of requests processed")
+	attrs["status_4xx"] = statsCount("The number of 4xx responses delivered")
+	attrs["status_5xx"] = statsCount("The number of 5xx responses delivered")
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Use this data source to get the [historical stats](https://developer.fastly.com/reference/api/metrics-stats/historical-stats/#get-hist-stats-service) of a single service totalled over a time range (e.g. for alerting thresholds or reports). Use `fastly_stats` for the individual sample windows, or `fastly_usage` for the account-wide usage.",
+
+		Attributes: attrs,
+	}
+}
+
+func (d *ServiceStats) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*helpers.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+	d.clientCtx = fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv)
+	d.tracer = providerData.Tracer
+}
+
+func (d *ServiceStats) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	api, finishSpan := d.tracer.Operation(ctx, helpers.API{Client: d.client, ClientCtx: d.clientCtx}, "fastly_service_stats.Read")
+	defer finishSpan(&resp.Diagnostics)
+
+	var data ServiceStatsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := statsQuery{By: data.By, From: data.From, Region: data.Region, ServiceID: data.ServiceID, To: data.To}
+	samples, err := query.read(ctx, api, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	// NOTE: The API only returns the individual sample windows for a service,
+	// so the stats are totalled here.
+	var bandwidth, errors, hits, miss, requests, status4xx, status5xx int64
+	for _, r := range samples {
+		bandwidth += int64(r.GetBandwidth())
+		errors += int64(r.GetErrors())
+		hits += int64(r.GetHits())
+		miss += int64(r.GetMiss())
+		requests += int64(r.GetRequests())
+		status4xx += int64(r.GetStatus4xx())
+		status5xx += int64(r.GetStatus5xx())
+	}
+
+	var hitRatio float64
+	if lookups := hits + miss; lookups > 0 {
+		hitRatio = float64(hits) / float64(lookups)
+	}
+
+	data.Bandwidth = types.Int64Value(bandwidth)
+	data.Errors = types.Int64Value(errors)
+	data.HitRatio = types.Float64Value(hitRatio)
+	data.Hits = types.Int64Value(hits)
+	data.Miss = types.Int64Value(miss)
+	data.Requests = types.Int64Value(requests)
+	data.Status4xx = types.Int64Value(status4xx)
+	data.Status5xx = types.Int64Value(status5xx)
+
+	data.ID = query.id()
+
+	tflog.Trace(ctx, "read service stats totals", map[string]any{"service_id": data.ServiceID.ValueString(), "samples": len(samples)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
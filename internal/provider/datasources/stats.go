@@ -0,0 +1,171 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &Stats{}
+
+// NewStats returns a new data source for reading historical service stats.
+func NewStats() datasource.DataSource {
+	return &Stats{}
+}
+
+// Stats defines the data source implementation.
+type Stats struct {
+	// client is a preconfigured instance of the Fastly API client.
+	client *fastly.APIClient
+	// clientCtx contains the user's API token.
+	clientCtx context.Context
+	// tracer exports the span of each read (nil if tracing is disabled).
+	tracer *helpers.Tracer
+}
+
+// StatsModel describes the data source data model.
+type StatsModel struct {
+	// By is the duration of each sample window.
+	By types.String `tfsdk:"by"`
+	// From is the start of the window to fetch stats for.
+	From types.String `tfsdk:"from"`
+	// ID is a unique identifier for the data source.
+	ID types.String `tfsdk:"id"`
+	// Region limits the stats to a specific geographic region.
+	Region types.String `tfsdk:"region"`
+	// ServiceID is the ID of the service to fetch stats for.
+	ServiceID types.String `tfsdk:"service_id"`
+	// Stats is the list of sample windows.
+	Stats []StatsSampleModel `tfsdk:"stats"`
+	// To is the end of the window to fetch stats for.
+	To types.String `tfsdk:"to"`
+}
+
+// StatsSampleModel describes the stats for a single sample window.
+type StatsSampleModel struct {
+	// Bandwidth is the total bytes delivered.
+	Bandwidth types.Int64 `tfsdk:"bandwidth"`
+	// Errors is the number of cache errors.
+	Errors types.Int64 `tfsdk:"errors"`
+	// HitRatio is the ratio of cache hits to cache misses.
+	HitRatio types.Float64 `tfsdk:"hit_ratio"`
+	// Hits is the number of cache hits.
+	Hits types.Int64 `tfsdk:"hits"`
+	// Miss is the number of cache misses.
+	Miss types.Int64 `tfsdk:"miss"`
+	// Requests is the number of requests processed.
+	Requests types.Int64 `tfsdk:"requests"`
+	// StartTime is the Unix timestamp of the start of the sample window.
+	StartTime types.Int64 `tfsdk:"start_time"`
+	// Status4xx is the number of 4xx responses delivered.
+	Status4xx types.Int64 `tfsdk:"status_4xx"`
+	// Status5xx is the number of 5xx responses delivered.
+	Status5xx types.Int64 `tfsdk:"status_5xx"`
+}
+
+func (d *Stats) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stats"
+}
+
+func (d *Stats) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := statsQueryAttributes("The duration of each sample window. One of `minute`, `hour` or `day` (the API defaults to `day`)")
+	attrs["stats"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The stats for each sample window",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"bandwidth": statsCount("The total bytes delivered"),
+				"errors":    statsCount("The number of cache errors"),
+				"hit_ratio": schema.Float64Attribute{
+					MarkdownDescription: "The ratio of cache hits to cache misses",
+					Computed:            true,
+				},
+				"hits":       statsCount("The number of cache hits"),
+				"miss":       statsCount("The number of cache misses"),
+				"requests":   statsCount("The number of requests processed"),
+				"start_time": statsCount("The Unix timestamp of the start of the sample window"),
+				"status_4xx": statsCount("The number of 4xx responses delivered"),
+				"status_5xx": statsCount("The number of 5xx responses delivered"),
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Use this data source to get the [historical stats](https://developer.fastly.com/reference/api/metrics-stats/historical-stats/#get-hist-stats-service) for a service over a time range.",
+
+		Attributes: attrs,
+	}
+}
+
+func (d *Stats) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*helpers.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+	d.clientCtx = fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv)
+	d.tracer = providerData.Tracer
+}
+
+func (d *Stats) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	api, finishSpan := d.tracer.Operation(ctx, helpers.API{Client: d.client, ClientCtx: d.clientCtx}, "fastly_stats.Read")
+	defer finishSpan(&resp.Diagnostics)
+
+	var data StatsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := statsQuery{By: data.By, From: data.From, Region: data.Region, ServiceID: data.ServiceID, To: data.To}
+	samples, err := query.read(ctx, api, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	data.Stats = []StatsSampleModel{}
+	for _, r := range samples {
+		data.Stats = append(data.Stats, StatsSampleModel{
+			Bandwidth: types.Int64Value(int64(r.GetBandwidth())),
+			Errors:    types.Int64Value(int64(r.GetErrors())),
+			HitRatio:  types.Float64Value(float64(r.GetHitRatio())),
+			Hits:      types.Int64Value(int64(r.GetHits())),
+			Miss:      types.Int64Value(int64(r.GetMiss())),
+			Requests:  types.Int64Value(int64(r.GetRequests())),
+			StartTime: types.Int64Value(int64(r.GetStartTime())),
+			Status4xx: types.Int64Value(int64(r.GetStatus4xx())),
+			Status5xx: types.Int64Value(int64(r.GetStatus5xx())),
+		})
+	}
+
+	data.ID = query.id()
+
+	tflog.Trace(ctx, "read service stats", map[string]any{"service_id": data.ServiceID.ValueString(), "samples": len(data.Stats)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,111 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// statsQuery is the historical stats query shared by the `fastly_stats` and
+// `fastly_service_stats` data sources.
+type statsQuery struct {
+	// By is the duration of each sample window.
+	By types.String
+	// From is the start of the window to fetch stats for.
+	From types.String
+	// Region limits the stats to a specific geographic region.
+	Region types.String
+	// ServiceID is the ID of the service to fetch stats for.
+	ServiceID types.String
+	// To is the end of the window to fetch stats for.
+	To types.String
+}
+
+// statsQueryAttributes returns the schema attributes of the query arguments
+// (and the ID derived from them).
+//
+// The `by` description is given, as it's used differently by each data source.
+func statsQueryAttributes(byDescription string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"by": schema.StringAttribute{
+			MarkdownDescription: byDescription,
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("minute", "hour", "day"),
+			},
+		},
+		"from": schema.StringAttribute{
+			MarkdownDescription: "The start of the window to fetch stats for (inclusive). Accepts a Unix timestamp or a relative time such as `two weeks ago`",
+			Required:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "An identifier derived from the query arguments",
+			Computed:            true,
+		},
+		"region": schema.StringAttribute{
+			MarkdownDescription: "Limit the stats to a specific geographic region. One of `usa`, `europe`, `anzac`, `asia`, `asia_india`, `asia_southkorea`, `africa_std` or `southamerica_std`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("usa", "europe", "anzac", "asia", "asia_india", "asia_southkorea", "africa_std", "southamerica_std"),
+			},
+		},
+		"service_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the service",
+			Required:            true,
+		},
+		"to": schema.StringAttribute{
+			MarkdownDescription: "The end of the window to fetch stats for. Accepts the same formats as `from` (the API defaults to now)",
+			Optional:            true,
+		},
+	}
+}
+
+// statsCount returns a computed stats count attribute.
+func statsCount(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: description,
+		Computed:            true,
+	}
+}
+
+// read returns the stats for each sample window.
+func (q statsQuery) read(ctx context.Context, api helpers.API, diags *diag.Diagnostics) ([]fastly.Results, error) {
+	clientReq := api.Client.HistoricalAPI.GetHistStatsService(api.ClientCtx, q.ServiceID.ValueString())
+	clientReq.From(q.From.ValueString())
+	if !q.To.IsNull() {
+		clientReq.To(q.To.ValueString())
+	}
+	if !q.By.IsNull() {
+		clientReq.By(q.By.ValueString())
+	}
+	if !q.Region.IsNull() {
+		clientReq.Region(q.Region.ValueString())
+	}
+
+	clientResp, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly HistoricalAPI.GetHistStatsService error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to read service stats")
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return nil, err
+	}
+
+	return clientResp.GetData(), nil
+}
+
+// id returns an identifier derived from the query arguments.
+func (q statsQuery) id() types.String {
+	return types.StringValue(fmt.Sprintf("%s/%s/%s/%s/%s", q.ServiceID.ValueString(), q.From.ValueString(), q.To.ValueString(), q.By.ValueString(), q.Region.ValueString()))
+}
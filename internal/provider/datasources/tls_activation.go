@@ -0,0 +1,248 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = &TLSActivation{}
+	_ datasource.DataSourceWithConfigValidators = &TLSActivation{}
+)
+
+// tlsActivationsPageSize is the number of activations listed per API call.
+const tlsActivationsPageSize = 100
+
+// NewTLSActivation returns a new data source for finding a TLS activation.
+func NewTLSActivation() datasource.DataSource {
+	return &TLSActivation{}
+}
+
+// TLSActivation defines the data source implementation.
+type TLSActivation struct {
+	// client is a preconfigured instance of the Fastly API client.
+	client *fastly.APIClient
+	// clientCtx contains the user's API token.
+	clientCtx context.Context
+	// tracer exports the span of each read (nil if tracing is disabled).
+	tracer *helpers.Tracer
+}
+
+// TLSActivationModel describes the data source data model.
+type TLSActivationModel struct {
+	// CertificateID is the ID of the activated TLS certificate.
+	CertificateID types.String `tfsdk:"certificate_id"`
+	// ConfigurationID is the ID of the TLS configuration used by the activation.
+	ConfigurationID types.String `tfsdk:"configuration_id"`
+	// CreatedAt is when the activation was created.
+	CreatedAt types.String `tfsdk:"created_at"`
+	// Domain is the domain the certificate is activated for.
+	Domain types.String `tfsdk:"domain"`
+	// ID is the ID of the activation.
+	ID types.String `tfsdk:"id"`
+}
+
+func (d *TLSActivation) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tls_activation"
+}
+
+func (d *TLSActivation) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Use this data source to find an existing [TLS activation](https://developer.fastly.com/reference/api/tls/custom-certs/activations/) (e.g. one created outside of Terraform) by its `id`, `domain`, `certificate_id` or `configuration_id`. Exactly one activation must match all the given filters.",
+
+		Attributes: map[string]schema.Attribute{
+			"certificate_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the activated TLS certificate. Can be set to filter the activations",
+				Computed:            true,
+				Optional:            true,
+			},
+			"configuration_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the TLS configuration used by the activation. Can be set to filter the activations",
+				Computed:            true,
+				Optional:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time (RFC 3339) the activation was created",
+				Computed:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain the certificate is activated for. Can be set to filter the activations",
+				Computed:            true,
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the activation",
+				Computed:            true,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// ConfigValidators returns a list of functions which will all be performed during validation.
+// https://developer.hashicorp.com/terraform/plugin/framework/data-sources/validate-configuration#configvalidators-method
+func (d *TLSActivation) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("certificate_id"),
+			path.MatchRoot("configuration_id"),
+			path.MatchRoot("domain"),
+			path.MatchRoot("id"),
+		),
+	}
+}
+
+func (d *TLSActivation) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*helpers.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+	d.clientCtx = fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv)
+	d.tracer = providerData.Tracer
+}
+
+func (d *TLSActivation) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	api, finishSpan := d.tracer.Operation(ctx, helpers.API{Client: d.client, ClientCtx: d.clientCtx}, "fastly_tls_activation.Read")
+	defer finishSpan(&resp.Diagnostics)
+
+	var data TLSActivationModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	activations, err := d.activations(ctx, api, data, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	var matches []TLSActivationModel
+	for _, activation := range activations {
+		if match := tlsActivation(activation); tlsActivationMatches(data, match) {
+			matches = append(matches, match)
+		}
+	}
+
+	activation, ok := exactlyOne("TLS activation", matches, func(a TLSActivationModel) string { return a.ID.ValueString() }, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	tflog.Trace(ctx, "read TLS activation", map[string]any{"id": activation.ID.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &activation)...)
+}
+
+// activations returns the activation with the given ID, or lists the
+// activations (filtered by the domain, certificate and configuration, if set).
+func (d *TLSActivation) activations(ctx context.Context, api helpers.API, data TLSActivationModel, diags *diag.Diagnostics) ([]fastly.TLSActivationResponseData, error) {
+	if !data.ID.IsNull() {
+		clientResp, httpResp, err := api.Client.TLSActivationsAPI.GetTLSActivation(api.ClientCtx, data.ID.ValueString()).Execute()
+		if err != nil {
+			tflog.Trace(ctx, "Fastly TLSActivationsAPI.GetTLSActivation error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+			helpers.APIError(httpResp, err, diags, fmt.Sprintf("Unable to read TLS activation '%s'", data.ID.ValueString()))
+			return nil, err
+		}
+		defer httpResp.Body.Close()
+		if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+			return nil, err
+		}
+		return []fastly.TLSActivationResponseData{clientResp.GetData()}, nil
+	}
+
+	var activations []fastly.TLSActivationResponseData
+	for page := int32(1); ; page++ {
+		clientReq := api.Client.TLSActivationsAPI.ListTLSActivations(api.ClientCtx)
+		clientReq.PageNumber(page)
+		clientReq.PageSize(tlsActivationsPageSize)
+		if !data.CertificateID.IsNull() {
+			clientReq.FilterTLSCertificateID(data.CertificateID.ValueString())
+		}
+		if !data.ConfigurationID.IsNull() {
+			clientReq.FilterTLSConfigurationID(data.ConfigurationID.ValueString())
+		}
+		if !data.Domain.IsNull() {
+			clientReq.FilterTLSDomainID(data.Domain.ValueString())
+		}
+
+		clientResp, httpResp, err := clientReq.Execute()
+		if err != nil {
+			tflog.Trace(ctx, "Fastly TLSActivationsAPI.ListTLSActivations error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+			helpers.APIError(httpResp, err, diags, "Unable to list TLS activations")
+			return nil, err
+		}
+		httpResp.Body.Close()
+		if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+			return nil, err
+		}
+
+		activations = append(activations, clientResp.GetData()...)
+
+		meta := clientResp.GetMeta()
+		if page >= meta.GetTotalPages() {
+			return activations, nil
+		}
+	}
+}
+
+// tlsActivation returns the data model of the activation.
+func tlsActivation(activation fastly.TLSActivationResponseData) TLSActivationModel {
+	attrs := activation.GetAttributes()
+	relationships := activation.GetRelationships()
+	cert := relationships.GetTLSCertificate()
+	config := relationships.GetTLSConfiguration()
+	domain := relationships.GetTLSDomain()
+
+	return TLSActivationModel{
+		CertificateID:   types.StringValue(cert.Data.GetID()),
+		ConfigurationID: types.StringValue(config.Data.GetID()),
+		CreatedAt:       helpers.Timestamp(attrs.CreatedAt),
+		Domain:          types.StringValue(domain.Data.GetID()),
+		ID:              types.StringValue(activation.GetID()),
+	}
+}
+
+// tlsActivationMatches reports whether the activation matches all the filters.
+func tlsActivationMatches(filters, activation TLSActivationModel) bool {
+	for _, filter := range []struct{ want, got types.String }{
+		{filters.CertificateID, activation.CertificateID},
+		{filters.ConfigurationID, activation.ConfigurationID},
+		{filters.Domain, activation.Domain},
+		{filters.ID, activation.ID},
+	} {
+		if !filter.want.IsNull() && !filter.want.Equal(filter.got) {
+			return false
+		}
+	}
+	return true
+}
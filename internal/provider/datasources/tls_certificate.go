@@ -0,0 +1,317 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = &TLSCertificate{}
+	_ datasource.DataSourceWithConfigValidators = &TLSCertificate{}
+)
+
+// tlsCertificatesPageSize is the number of certificates listed per API call.
+const tlsCertificatesPageSize = 100
+
+// NewTLSCertificate returns a new data source for finding a TLS certificate.
+func NewTLSCertificate() datasource.DataSource {
+	return &TLSCertificate{}
+}
+
+// TLSCertificate defines the data source implementation.
+type TLSCertificate struct {
+	// client is a preconfigured instance of the Fastly API client.
+	client *fastly.APIClient
+	// clientCtx contains the user's API token.
+	clientCtx context.Context
+	// tracer exports the span of each read (nil if tracing is disabled).
+	tracer *helpers.Tracer
+}
+
+// TLSCertificateModel describes the data source data model.
+type TLSCertificateModel struct {
+	// CreatedAt is when the certificate was uploaded.
+	CreatedAt types.String `tfsdk:"created_at"`
+	// Domain filters the certificates by a domain they are valid for.
+	Domain types.String `tfsdk:"domain"`
+	// Domains are the domains (SAN entries) the certificate is valid for.
+	Domains types.Set `tfsdk:"domains"`
+	// ID is the ID of the certificate.
+	ID types.String `tfsdk:"id"`
+	// IssuedTo is the hostname the certificate was issued to.
+	IssuedTo types.String `tfsdk:"issued_to"`
+	// Issuer is the certificate authority that issued the certificate.
+	Issuer types.String `tfsdk:"issuer"`
+	// Name is the name of the certificate.
+	Name types.String `tfsdk:"name"`
+	// NotAfter is when the certificate expires.
+	NotAfter types.String `tfsdk:"not_after"`
+	// NotBefore is when the certificate becomes valid.
+	NotBefore types.String `tfsdk:"not_before"`
+	// Replace indicates Fastly recommends replacing the certificate.
+	Replace types.Bool `tfsdk:"replace"`
+	// SerialNumber is the serial number of the certificate.
+	SerialNumber types.String `tfsdk:"serial_number"`
+	// SignatureAlgorithm is the algorithm used to sign the certificate.
+	SignatureAlgorithm types.String `tfsdk:"signature_algorithm"`
+	// UpdatedAt is when the certificate was last updated.
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (d *TLSCertificate) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tls_certificate"
+}
+
+func (d *TLSCertificate) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Use this data source to find an existing [custom TLS certificate](https://developer.fastly.com/reference/api/tls/custom-certs/certificates/) (e.g. one uploaded outside of Terraform) by its `id`, `name`, `issued_to` or one of its `domains`. Exactly one certificate must match all the given filters.",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time (RFC 3339) the certificate was uploaded",
+				Computed:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Filters the certificates by a domain (SAN entry) they are valid for",
+				Optional:            true,
+			},
+			"domains": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The domains (SAN entries) the certificate is valid for",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the certificate",
+				Computed:            true,
+				Optional:            true,
+			},
+			"issued_to": schema.StringAttribute{
+				MarkdownDescription: "The hostname the certificate was issued to. Can be set to filter the certificates",
+				Computed:            true,
+				Optional:            true,
+			},
+			"issuer": schema.StringAttribute{
+				MarkdownDescription: "The certificate authority that issued the certificate",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the certificate. Can be set to filter the certificates",
+				Computed:            true,
+				Optional:            true,
+			},
+			"not_after": schema.StringAttribute{
+				MarkdownDescription: "The date and time (RFC 3339) the certificate expires",
+				Computed:            true,
+			},
+			"not_before": schema.StringAttribute{
+				MarkdownDescription: "The date and time (RFC 3339) the certificate becomes valid",
+				Computed:            true,
+			},
+			"replace": schema.BoolAttribute{
+				MarkdownDescription: "Whether Fastly recommends replacing the certificate (e.g. it's about to expire)",
+				Computed:            true,
+			},
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: "The serial number of the certificate",
+				Computed:            true,
+			},
+			"signature_algorithm": schema.StringAttribute{
+				MarkdownDescription: "The algorithm used to sign the certificate",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time (RFC 3339) the certificate was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ConfigValidators returns a list of functions which will all be performed during validation.
+// https://developer.hashicorp.com/terraform/plugin/framework/data-sources/validate-configuration#configvalidators-method
+func (d *TLSCertificate) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("domain"),
+			path.MatchRoot("id"),
+			path.MatchRoot("issued_to"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *TLSCertificate) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*helpers.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+	d.clientCtx = fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv)
+	d.tracer = providerData.Tracer
+}
+
+func (d *TLSCertificate) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	api, finishSpan := d.tracer.Operation(ctx, helpers.API{Client: d.client, ClientCtx: d.clientCtx}, "fastly_tls_certificate.Read")
+	defer finishSpan(&resp.Diagnostics)
+
+	var data TLSCertificateModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certs, err := d.certificates(ctx, api, data, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	var matches []fastly.TLSCertificateResponseData
+	for _, cert := range certs {
+		if tlsCertificateMatches(data, cert) {
+			matches = append(matches, cert)
+		}
+	}
+
+	cert, ok := exactlyOne("TLS certificate", matches, func(c fastly.TLSCertificateResponseData) string { return c.GetID() }, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	setTLSCertificate(ctx, &data, cert, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read TLS certificate", map[string]any{"id": data.ID.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// certificates returns the certificate with the given ID, or lists the
+// certificates (filtered by the domain, if set).
+func (d *TLSCertificate) certificates(ctx context.Context, api helpers.API, data TLSCertificateModel, diags *diag.Diagnostics) ([]fastly.TLSCertificateResponseData, error) {
+	if !data.ID.IsNull() {
+		clientResp, httpResp, err := api.Client.TLSCertificatesAPI.GetTLSCert(api.ClientCtx, data.ID.ValueString()).Execute()
+		if err != nil {
+			tflog.Trace(ctx, "Fastly TLSCertificatesAPI.GetTLSCert error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+			helpers.APIError(httpResp, err, diags, fmt.Sprintf("Unable to read TLS certificate '%s'", data.ID.ValueString()))
+			return nil, err
+		}
+		defer httpResp.Body.Close()
+		if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+			return nil, err
+		}
+		return []fastly.TLSCertificateResponseData{clientResp.GetData()}, nil
+	}
+
+	var certs []fastly.TLSCertificateResponseData
+	for page := int32(1); ; page++ {
+		clientReq := api.Client.TLSCertificatesAPI.ListTLSCerts(api.ClientCtx)
+		clientReq.PageNumber(page)
+		clientReq.PageSize(tlsCertificatesPageSize)
+		if !data.Domain.IsNull() {
+			clientReq.FilterTLSDomainsID(data.Domain.ValueString())
+		}
+
+		clientResp, httpResp, err := clientReq.Execute()
+		if err != nil {
+			tflog.Trace(ctx, "Fastly TLSCertificatesAPI.ListTLSCerts error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+			helpers.APIError(httpResp, err, diags, "Unable to list TLS certificates")
+			return nil, err
+		}
+		httpResp.Body.Close()
+		if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+			return nil, err
+		}
+
+		certs = append(certs, clientResp.GetData()...)
+
+		meta := clientResp.GetMeta()
+		if page >= meta.GetTotalPages() {
+			return certs, nil
+		}
+	}
+}
+
+// tlsCertificateMatches reports whether the certificate matches all the filters.
+func tlsCertificateMatches(data TLSCertificateModel, cert fastly.TLSCertificateResponseData) bool {
+	attrs := cert.GetAttributes()
+
+	if !data.ID.IsNull() && cert.GetID() != data.ID.ValueString() {
+		return false
+	}
+	if !data.IssuedTo.IsNull() && attrs.GetIssuedTo() != data.IssuedTo.ValueString() {
+		return false
+	}
+	if name, _ := attrs.AdditionalProperties["name"].(string); !data.Name.IsNull() && name != data.Name.ValueString() {
+		return false
+	}
+	if !data.Domain.IsNull() {
+		for _, domain := range cert.Relationships.GetTLSDomains().Data {
+			if domain.GetID() == data.Domain.ValueString() {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// setTLSCertificate populates the data model from the certificate.
+//
+// NOTE: The API client doesn't model the certificate name, so it's read from
+// the undecoded attributes.
+func setTLSCertificate(ctx context.Context, data *TLSCertificateModel, cert fastly.TLSCertificateResponseData, diags *diag.Diagnostics) {
+	attrs := cert.GetAttributes()
+
+	var domains []string
+	for _, domain := range cert.Relationships.GetTLSDomains().Data {
+		domains = append(domains, domain.GetID())
+	}
+	domainsValue, d := types.SetValueFrom(ctx, types.StringType, domains)
+	diags.Append(d...)
+
+	name, _ := attrs.AdditionalProperties["name"].(string)
+
+	data.CreatedAt = helpers.Timestamp(attrs.CreatedAt)
+	data.Domains = domainsValue
+	data.ID = types.StringValue(cert.GetID())
+	data.IssuedTo = types.StringValue(attrs.GetIssuedTo())
+	data.Issuer = types.StringValue(attrs.GetIssuer())
+	data.Name = types.StringValue(name)
+	data.NotAfter = helpers.Timestamp(*fastly.NewNullableTime(attrs.NotAfter))
+	data.NotBefore = helpers.Timestamp(*fastly.NewNullableTime(attrs.NotBefore))
+	data.Replace = types.BoolValue(attrs.GetReplace())
+	data.SerialNumber = types.StringValue(attrs.GetSerialNumber())
+	data.SignatureAlgorithm = types.StringValue(attrs.GetSignatureAlgorithm())
+	data.UpdatedAt = helpers.Timestamp(attrs.UpdatedAt)
+}
@@ -0,0 +1,295 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &Usage{}
+
+// NewUsage returns a new data source for reading account usage and billing.
+func NewUsage() datasource.DataSource {
+	return &Usage{}
+}
+
+// Usage defines the data source implementation.
+type Usage struct {
+	// client is a preconfigured instance of the Fastly API client.
+	client *fastly.APIClient
+	// clientCtx contains the user's API token.
+	clientCtx context.Context
+	// tracer exports the span of each read (nil if tracing is disabled).
+	tracer *helpers.Tracer
+}
+
+// UsageModel describes the data source data model.
+type UsageModel struct {
+	// Billing is the estimated billing for the month.
+	Billing *UsageBillingModel `tfsdk:"billing"`
+	// CustomerID is the ID of the customer the usage belongs to.
+	CustomerID types.String `tfsdk:"customer_id"`
+	// ID is a unique identifier for the data source (year-month).
+	ID types.String `tfsdk:"id"`
+	// LineItems is the estimated billing broken down by product.
+	LineItems []UsageLineItemModel `tfsdk:"line_items"`
+	// Month is the two digit month of the usage period.
+	Month types.String `tfsdk:"month"`
+	// Regions is the usage for the month, organized by region.
+	Regions map[string]UsageRegionModel `tfsdk:"regions"`
+	// Year is the four digit year of the usage period.
+	Year types.String `tfsdk:"year"`
+}
+
+// UsageBillingModel describes the estimated billing totals.
+type UsageBillingModel struct {
+	// BandwidthCost is the cost of bandwidth.
+	BandwidthCost types.Float64 `tfsdk:"bandwidth_cost"`
+	// Cost is the total cost.
+	Cost types.Float64 `tfsdk:"cost"`
+	// Discount is the discount applied.
+	Discount types.Float64 `tfsdk:"discount"`
+	// ExtrasCost is the cost of extra products.
+	ExtrasCost types.Float64 `tfsdk:"extras_cost"`
+	// IncurredCost is the cost incurred so far.
+	IncurredCost types.Float64 `tfsdk:"incurred_cost"`
+	// PlanName is the name of the billing plan.
+	PlanName types.String `tfsdk:"plan_name"`
+	// RequestsCost is the cost of requests.
+	RequestsCost types.Float64 `tfsdk:"requests_cost"`
+}
+
+// UsageLineItemModel describes a single billing line item.
+type UsageLineItemModel struct {
+	// Amount is the cost of the line item.
+	Amount types.Float64 `tfsdk:"amount"`
+	// Description is the product the line item is for.
+	Description types.String `tfsdk:"description"`
+	// PerUnitCost is the cost per unit.
+	PerUnitCost types.Float64 `tfsdk:"per_unit_cost"`
+	// Units is the number of billable units.
+	Units types.Float64 `tfsdk:"units"`
+}
+
+// UsageRegionModel describes the usage for a single region.
+type UsageRegionModel struct {
+	// Bandwidth is the bandwidth used.
+	Bandwidth types.Float64 `tfsdk:"bandwidth"`
+	// ComputeRequests is the number of Compute requests.
+	ComputeRequests types.Float64 `tfsdk:"compute_requests"`
+	// Requests is the number of requests.
+	Requests types.Float64 `tfsdk:"requests"`
+}
+
+func (d *Usage) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage"
+}
+
+func (d *Usage) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	cost := func(description string) schema.Float64Attribute {
+		return schema.Float64Attribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Use this data source to get the account [usage](https://developer.fastly.com/reference/api/metrics-stats/historical-stats/#get-usage-month) by region and the [estimated billing](https://developer.fastly.com/reference/api/account/billing/#get-invoice-mtd) by product for a month. Reading billing data requires a token for a user with the `billing` role.",
+
+		Attributes: map[string]schema.Attribute{
+			"billing": schema.SingleNestedAttribute{
+				MarkdownDescription: "The estimated billing totals for the month",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"bandwidth_cost": cost("The cost of bandwidth"),
+					"cost":           cost("The total cost"),
+					"discount":       cost("The discount applied"),
+					"extras_cost":    cost("The cost of extra products"),
+					"incurred_cost":  cost("The cost incurred so far"),
+					"plan_name": schema.StringAttribute{
+						MarkdownDescription: "The name of the billing plan",
+						Computed:            true,
+					},
+					"requests_cost": cost("The cost of requests"),
+				},
+			},
+			"customer_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the customer the usage belongs to",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "An identifier in the format `<year>-<month>`",
+				Computed:            true,
+			},
+			"line_items": schema.ListNestedAttribute{
+				MarkdownDescription: "The estimated billing broken down by product",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"amount": cost("The cost of the line item"),
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The product the line item is for",
+							Computed:            true,
+						},
+						"per_unit_cost": cost("The cost per unit"),
+						"units": schema.Float64Attribute{
+							MarkdownDescription: "The number of billable units",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"month": schema.StringAttribute{
+				MarkdownDescription: "The two digit month of the usage period (e.g. `05`)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("01", "02", "03", "04", "05", "06", "07", "08", "09", "10", "11", "12"),
+				},
+			},
+			"regions": schema.MapNestedAttribute{
+				MarkdownDescription: "The usage for the month, keyed by region",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"bandwidth": schema.Float64Attribute{
+							MarkdownDescription: "The bandwidth used (in bytes)",
+							Computed:            true,
+						},
+						"compute_requests": schema.Float64Attribute{
+							MarkdownDescription: "The number of Compute requests",
+							Computed:            true,
+						},
+						"requests": schema.Float64Attribute{
+							MarkdownDescription: "The number of requests",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"year": schema.StringAttribute{
+				MarkdownDescription: "The four digit year of the usage period (e.g. `2024`)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(4, 4),
+				},
+			},
+		},
+	}
+}
+
+func (d *Usage) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*helpers.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+	d.clientCtx = fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv)
+	d.tracer = providerData.Tracer
+}
+
+func (d *Usage) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	api, finishSpan := d.tracer.Operation(ctx, helpers.API{Client: d.client, ClientCtx: d.clientCtx}, "fastly_usage.Read")
+	defer finishSpan(&resp.Diagnostics)
+
+	var data UsageModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	year := data.Year.ValueString()
+	month := data.Month.ValueString()
+
+	usageReq := api.Client.HistoricalAPI.GetUsageMonth(api.ClientCtx)
+	usageReq.Year(year)
+	usageReq.Month(month)
+	usageResp, httpResp, err := usageReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly HistoricalAPI.GetUsageMonth error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to read usage")
+		return
+	}
+	if err := helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	usage := usageResp.GetData()
+	customerID := usage.GetCustomerID()
+
+	data.CustomerID = types.StringValue(customerID)
+	data.Regions = make(map[string]UsageRegionModel)
+	for region, u := range usage.GetTotal() {
+		data.Regions[region] = UsageRegionModel{
+			Bandwidth:       types.Float64Value(float64(u.GetBandwidth())),
+			ComputeRequests: types.Float64Value(float64(u.GetComputeRequests())),
+			Requests:        types.Float64Value(float64(u.GetRequests())),
+		}
+	}
+
+	billingReq := api.Client.BillingAPI.GetInvoiceMtd(api.ClientCtx, customerID)
+	billingReq.Year(year)
+	billingReq.Month(month)
+	billingResp, httpResp, err := billingReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly BillingAPI.GetInvoiceMtd error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to read billing estimate")
+		return
+	}
+	if err := helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	total := billingResp.GetTotal()
+	data.Billing = &UsageBillingModel{
+		BandwidthCost: types.Float64Value(float64(total.GetBandwidthCost())),
+		Cost:          types.Float64Value(float64(total.GetCost())),
+		Discount:      types.Float64Value(float64(total.GetDiscount())),
+		ExtrasCost:    types.Float64Value(float64(total.GetExtrasCost())),
+		IncurredCost:  types.Float64Value(float64(total.GetIncurredCost())),
+		PlanName:      types.StringValue(total.GetPlanName()),
+		RequestsCost:  types.Float64Value(float64(total.GetRequestsCost())),
+	}
+
+	data.LineItems = []UsageLineItemModel{}
+	for _, item := range billingResp.GetLineItems() {
+		data.LineItems = append(data.LineItems, UsageLineItemModel{
+			Amount:      types.Float64Value(float64(item.GetAmount())),
+			Description: types.StringValue(item.GetDescription()),
+			PerUnitCost: types.Float64Value(float64(item.GetPerUnitCost())),
+			Units:       types.Float64Value(float64(item.GetUnits())),
+		})
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s-%s", year, month))
+
+	tflog.Trace(ctx, "read usage", map[string]any{"year": year, "month": month, "regions": len(data.Regions)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,133 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VCLBoilerplate{}
+
+// NewVCLBoilerplate returns a new data source for reading the VCL boilerplate.
+func NewVCLBoilerplate() datasource.DataSource {
+	return &VCLBoilerplate{}
+}
+
+// VCLBoilerplate defines the data source implementation.
+type VCLBoilerplate struct {
+	// client is a preconfigured instance of the Fastly API client.
+	client *fastly.APIClient
+	// clientCtx contains the user's API token.
+	clientCtx context.Context
+	// tracer exports the span of each read (nil if tracing is disabled).
+	tracer *helpers.Tracer
+}
+
+// VCLBoilerplateModel describes the data source data model.
+type VCLBoilerplateModel struct {
+	// Content is the boilerplate VCL.
+	Content types.String `tfsdk:"content"`
+	// ID is a unique identifier for the data source (service_id/version).
+	ID types.String `tfsdk:"id"`
+	// ServiceID is the ID of the service the boilerplate is generated for.
+	ServiceID types.String `tfsdk:"service_id"`
+	// Version is the service version the boilerplate is generated for.
+	Version types.Int64 `tfsdk:"version"`
+}
+
+func (d *VCLBoilerplate) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vcl_boilerplate"
+}
+
+func (d *VCLBoilerplate) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Use this data source to get the Fastly [boilerplate VCL](https://developer.fastly.com/learning/vcl/using/#using-the-vcl-boilerplate) for a service version. The boilerplate includes the service's TTL from its settings and can be used as the starting point for a custom main VCL file.",
+
+		Attributes: map[string]schema.Attribute{
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The boilerplate VCL",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "An identifier in the format `<service_id>/<version>`",
+				Computed:            true,
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service",
+				Required:            true,
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "The service version whose settings are used to render the boilerplate",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (d *VCLBoilerplate) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*helpers.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+	d.clientCtx = fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv)
+	d.tracer = providerData.Tracer
+}
+
+func (d *VCLBoilerplate) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	api, finishSpan := d.tracer.Operation(ctx, helpers.API{Client: d.client, ClientCtx: d.clientCtx}, "fastly_vcl_boilerplate.Read")
+	defer finishSpan(&resp.Diagnostics)
+
+	var data VCLBoilerplateModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := data.ServiceID.ValueString()
+	serviceVersion := data.Version.ValueInt64()
+
+	clientReq := api.Client.VclAPI.GetCustomVclBoilerplate(api.ClientCtx, serviceID, int32(serviceVersion))
+	clientResp, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly VclAPI.GetCustomVclBoilerplate error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to read VCL boilerplate")
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	data.Content = types.StringValue(clientResp)
+	data.ID = types.StringValue(fmt.Sprintf("%s/%d", serviceID, serviceVersion))
+
+	tflog.Trace(ctx, "read VCL boilerplate", map[string]any{"service_id": serviceID, "version": serviceVersion})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -10,6 +10,9 @@ import (
 
 // Resource represents an entity that has an associated Fastly API endpoint.
 type Resource interface {
+	// Attribute returns the name of the top-level service attribute that the
+	// nested resource is responsible for (e.g. `domains`).
+	Attribute() string
 	// Create is called when the provider must create a new resource.
 	// Config and planned state values should be read from the CreateRequest.
 	// New state values set on the CreateResponse.
@@ -56,4 +59,7 @@ type Resource interface {
 	) (bool, error)
 	// HasChanges indicates if the nested resource contains configuration changes.
 	HasChanges() bool
+	// ChangeCounts returns the number of added, deleted and modified entities
+	// detected by the last call to InspectChanges.
+	ChangeCounts() (added, deleted, modified int)
 }
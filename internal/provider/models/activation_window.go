@@ -0,0 +1,17 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ActivationWindow describes the window a service version can be activated in.
+type ActivationWindow struct {
+	// Days are the days of the week the window opens on.
+	Days []types.String `tfsdk:"days"`
+	// End is the time of day the window closes.
+	End types.String `tfsdk:"end"`
+	// Start is the time of day the window opens.
+	Start types.String `tfsdk:"start"`
+	// TimeZone is the time zone of the window.
+	TimeZone types.String `tfsdk:"time_zone"`
+}
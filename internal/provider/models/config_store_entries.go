@@ -0,0 +1,17 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ConfigStoreEntries describes the resource data model.
+type ConfigStoreEntries struct {
+	// Entries is a map of the config store entry keys to their values.
+	Entries types.Map `tfsdk:"entries"`
+	// ID is a unique ID for the resource (the store ID).
+	ID types.String `tfsdk:"id"`
+	// ManageItems indicates Terraform owns every entry in the store.
+	ManageItems types.Bool `tfsdk:"manage_items"`
+	// StoreID is the ID of the config store the entries belong to.
+	StoreID types.String `tfsdk:"store_id"`
+}
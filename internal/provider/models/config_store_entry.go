@@ -0,0 +1,17 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ConfigStoreEntry describes the resource data model.
+type ConfigStoreEntry struct {
+	// ID is a unique ID for the entry (store ID and key).
+	ID types.String `tfsdk:"id"`
+	// Key is the config store entry key.
+	Key types.String `tfsdk:"key"`
+	// StoreID is the ID of the config store the entry belongs to.
+	StoreID types.String `tfsdk:"store_id"`
+	// Value is the config store entry value.
+	Value types.String `tfsdk:"value"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Dictionary is a nested map attribute for the dictionaries (edge dictionary
+// containers) associated with a service.
+type Dictionary struct {
+	// DictionaryID is the alphanumeric string identifying the dictionary.
+	DictionaryID types.String `tfsdk:"dictionary_id"`
+	// Name is a required field representing the dictionary name.
+	Name types.String `tfsdk:"name"`
+	// NamePast is internally used for tracking changes.
+	NamePast types.String `tfsdk:"-"`
+	// WriteOnly indicates the dictionary items can't be read back from the API.
+	WriteOnly types.Bool `tfsdk:"write_only"`
+}
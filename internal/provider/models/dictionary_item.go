@@ -0,0 +1,21 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DictionaryItem describes the resource data model.
+type DictionaryItem struct {
+	// DictionaryID is the ID of the dictionary the item belongs to.
+	DictionaryID types.String `tfsdk:"dictionary_id"`
+	// ID is a unique ID for the item (service ID, dictionary ID and key).
+	ID types.String `tfsdk:"id"`
+	// Key is the dictionary item key.
+	Key types.String `tfsdk:"key"`
+	// ServiceID is the ID of the service the dictionary belongs to.
+	ServiceID types.String `tfsdk:"service_id"`
+	// Value is the dictionary item value.
+	Value types.String `tfsdk:"value"`
+	// WriteOnly indicates the dictionary is write-only (private).
+	WriteOnly types.Bool `tfsdk:"write_only"`
+}
@@ -8,8 +8,14 @@ import (
 type Domain struct {
 	// Comment is an optional comment about the domain.
 	Comment types.String `tfsdk:"comment"`
+	// CreatedAt is the date and time the domain was created (RFC 3339).
+	CreatedAt types.String `tfsdk:"created_at"`
+	// IsApex indicates if the domain is an apex domain (e.g. example.com).
+	IsApex types.Bool `tfsdk:"is_apex"`
 	// Name is a required field representing the domain name.
 	Name types.String `tfsdk:"name"`
 	// NamePast is internally used for tracking changes.
 	NamePast types.String `tfsdk:"-"`
+	// UpdatedAt is the date and time the domain was last updated (RFC 3339).
+	UpdatedAt types.String `tfsdk:"updated_at"`
 }
@@ -0,0 +1,13 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Fanout describes the resource data model.
+type Fanout struct {
+	// ID is a unique ID for the resource (the service ID).
+	ID types.String `tfsdk:"id"`
+	// ServiceID is the ID of the Compute service to enable Fanout for.
+	ServiceID types.String `tfsdk:"service_id"`
+}
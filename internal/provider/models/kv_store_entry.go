@@ -0,0 +1,27 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// KVStoreEntry describes the resource data model.
+type KVStoreEntry struct {
+	// Generation is the generation of the value, used for conditional writes.
+	Generation types.String `tfsdk:"generation"`
+	// ID is a unique ID for the entry (store ID and key).
+	ID types.String `tfsdk:"id"`
+	// Key is the entry key.
+	Key types.String `tfsdk:"key"`
+	// Metadata is arbitrary data associated with the entry.
+	Metadata types.String `tfsdk:"metadata"`
+	// Source is the path of a file containing the entry value.
+	Source types.String `tfsdk:"source"`
+	// SourceHash triggers an update when the content of Source changes.
+	SourceHash types.String `tfsdk:"source_hash"`
+	// StoreID is the ID of the KV store the entry belongs to.
+	StoreID types.String `tfsdk:"store_id"`
+	// TimeToLive is the number of seconds until the entry expires.
+	TimeToLive types.Int64 `tfsdk:"time_to_live"`
+	// Value is the entry value.
+	Value types.String `tfsdk:"value"`
+}
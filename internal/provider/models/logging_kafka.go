@@ -0,0 +1,48 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// LoggingKafka is a nested map attribute for the Kafka logging endpoint(s)
+// associated with a service.
+type LoggingKafka struct {
+	// AuthMethod is the SASL authentication method.
+	AuthMethod types.String `tfsdk:"auth_method"`
+	// Brokers is a comma-separated list of the Kafka brokers (host:port).
+	Brokers types.String `tfsdk:"brokers"`
+	// CompressionCodec is the codec used to compress the log messages.
+	CompressionCodec types.String `tfsdk:"compression_codec"`
+	// Format is the Apache-style log format string.
+	Format types.String `tfsdk:"format"`
+	// FormatVersion is the version of the custom logging format.
+	FormatVersion types.Int64 `tfsdk:"format_version"`
+	// Name is a required field representing the logging endpoint name.
+	Name types.String `tfsdk:"name"`
+	// ParseLogKeyvals enables parsing key/value pairs of the log line.
+	ParseLogKeyvals types.Bool `tfsdk:"parse_log_keyvals"`
+	// Password is the SASL password.
+	Password types.String `tfsdk:"password" sensitive:"true"`
+	// Placement is where in the generated VCL the logging call is placed.
+	Placement types.String `tfsdk:"placement"`
+	// RequestMaxBytes is the maximum number of bytes sent in one request.
+	RequestMaxBytes types.Int64 `tfsdk:"request_max_bytes"`
+	// RequiredAcks is the number of acknowledgements a leader must receive.
+	RequiredAcks types.Int64 `tfsdk:"required_acks"`
+	// ResponseCondition is the name of the condition controlling when to log.
+	ResponseCondition types.String `tfsdk:"response_condition"`
+	// TLSCACert is the CA certificate used to verify the brokers.
+	TLSCACert types.String `tfsdk:"tls_ca_cert"`
+	// TLSClientCert is the client certificate used to authenticate.
+	TLSClientCert types.String `tfsdk:"tls_client_cert"`
+	// TLSClientKey is the client private key used to authenticate.
+	TLSClientKey types.String `tfsdk:"tls_client_key" sensitive:"true"`
+	// TLSHostname is the hostname used to verify the brokers' certificates.
+	TLSHostname types.String `tfsdk:"tls_hostname"`
+	// Topic is the Kafka topic to send the logs to.
+	Topic types.String `tfsdk:"topic"`
+	// UseTLS enables TLS for the connection to the brokers.
+	UseTLS types.Bool `tfsdk:"use_tls"`
+	// User is the SASL user.
+	User types.String `tfsdk:"user" sensitive:"true"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// LoggingKinesis is a nested map attribute for the Amazon Kinesis logging
+// endpoint(s) associated with a service.
+type LoggingKinesis struct {
+	// AccessKey is the AWS access key ID.
+	AccessKey types.String `tfsdk:"access_key" sensitive:"true"`
+	// Format is the Apache-style log format string.
+	Format types.String `tfsdk:"format"`
+	// FormatVersion is the version of the custom logging format.
+	FormatVersion types.Int64 `tfsdk:"format_version"`
+	// IAMRole is the ARN of the IAM role Fastly assumes to write to the stream.
+	IAMRole types.String `tfsdk:"iam_role"`
+	// Name is a required field representing the logging endpoint name.
+	Name types.String `tfsdk:"name"`
+	// Placement is where in the generated VCL the logging call is placed.
+	Placement types.String `tfsdk:"placement"`
+	// Region is the AWS region of the stream.
+	Region types.String `tfsdk:"region"`
+	// SecretKey is the AWS secret access key.
+	SecretKey types.String `tfsdk:"secret_key" sensitive:"true"`
+	// Topic is the name of the Kinesis stream to send the logs to.
+	Topic types.String `tfsdk:"topic"`
+}
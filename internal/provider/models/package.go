@@ -0,0 +1,29 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Package describes the resource data model.
+type Package struct {
+	// Filename is the path to the Compute package to upload.
+	Filename types.String `tfsdk:"filename"`
+	// FilesHash is a hash of the files within the uploaded package.
+	FilesHash types.String `tfsdk:"files_hash"`
+	// Hashsum is a hash of the uploaded package.
+	Hashsum types.String `tfsdk:"hashsum"`
+	// ID is a unique ID for the package (service ID and version).
+	ID types.String `tfsdk:"id"`
+	// Language is the language of the uploaded package.
+	Language types.String `tfsdk:"language"`
+	// Name is the name of the uploaded package.
+	Name types.String `tfsdk:"name"`
+	// ServiceID is the ID of the service to upload the package to.
+	ServiceID types.String `tfsdk:"service_id"`
+	// Size is the size of the uploaded package in bytes.
+	Size types.Int64 `tfsdk:"size"`
+	// SourceCodeHash is an arbitrary hash that triggers a new upload when changed.
+	SourceCodeHash types.String `tfsdk:"source_code_hash"`
+	// Version is the service version to upload the package to.
+	Version types.Int64 `tfsdk:"version"`
+}
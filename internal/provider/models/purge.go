@@ -0,0 +1,23 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Purge describes the resource data model.
+type Purge struct {
+	// All purges all content for the service.
+	All types.Bool `tfsdk:"all"`
+	// ID is a unique ID for the purge.
+	ID types.String `tfsdk:"id"`
+	// ServiceID is the ID of the service to purge.
+	ServiceID types.String `tfsdk:"service_id"`
+	// Soft marks content as outdated (stale) instead of removing it.
+	Soft types.Bool `tfsdk:"soft"`
+	// SurrogateKeys is a set of surrogate keys to purge.
+	SurrogateKeys []types.String `tfsdk:"surrogate_keys"`
+	// Triggers is a map of arbitrary values that force a new purge when changed.
+	Triggers map[string]types.String `tfsdk:"triggers"`
+	// URL is a single URL to purge.
+	URL types.String `tfsdk:"url"`
+}
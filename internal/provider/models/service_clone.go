@@ -0,0 +1,25 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ServiceClone describes the resource data model.
+type ServiceClone struct {
+	// Comment is a description field for the cloned service.
+	Comment types.String `tfsdk:"comment"`
+	// Domains are the domains added to the cloned service version.
+	Domains types.Set `tfsdk:"domains"`
+	// ForceDestroy deactivates the cloned service before it's destroyed.
+	ForceDestroy types.Bool `tfsdk:"force_destroy"`
+	// ID is the ID of the cloned service.
+	ID types.String `tfsdk:"id"`
+	// Name is the name of the cloned service.
+	Name types.String `tfsdk:"name"`
+	// SourceServiceID is the ID of the service to copy.
+	SourceServiceID types.String `tfsdk:"source_service_id"`
+	// SourceVersion is the service version to copy.
+	SourceVersion types.Int64 `tfsdk:"source_version"`
+	// Version is the cloned service version holding the copied configuration.
+	Version types.Int64 `tfsdk:"version"`
+}
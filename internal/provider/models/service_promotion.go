@@ -0,0 +1,29 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ServicePromotion describes the resource data model.
+type ServicePromotion struct {
+	// ActivationWindow restricts when a service version can be activated.
+	ActivationWindow *ActivationWindow `tfsdk:"activation_window"`
+	// ActivationWindowOverride allows an activation outside of the activation window.
+	ActivationWindowOverride types.Bool `tfsdk:"activation_window_override"`
+	// Active indicates if the promoted version is still the active version.
+	Active types.Bool `tfsdk:"active"`
+	// ID is a unique ID for the promotion (the service ID).
+	ID types.String `tfsdk:"id"`
+	// PreviousVersion is the version that was active before the promotion.
+	PreviousVersion types.Int64 `tfsdk:"previous_version"`
+	// RequireLocked requires the version to be locked before it's promoted.
+	RequireLocked types.Bool `tfsdk:"require_locked"`
+	// ServiceID is the ID of the service.
+	ServiceID types.String `tfsdk:"service_id"`
+	// Validate requires the version to pass validation before it's promoted.
+	Validate types.Bool `tfsdk:"validate"`
+	// Version is the service version to promote (activate).
+	Version types.Int64 `tfsdk:"version"`
+	// WaitForDeployment waits for the promoted version to be deployed.
+	WaitForDeployment types.Bool `tfsdk:"wait_for_deployment"`
+}
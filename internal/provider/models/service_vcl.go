@@ -8,32 +8,88 @@ import (
 type ServiceVCL struct {
 	// Activate controls whether the service should be activated.
 	Activate types.Bool `tfsdk:"activate"`
+	// ActivationWindow restricts when a service version can be activated.
+	ActivationWindow *ActivationWindow `tfsdk:"activation_window"`
+	// ActivationWindowOverride allows an activation outside of the activation window.
+	ActivationWindowOverride types.Bool `tfsdk:"activation_window_override"`
+	// ActiveTrafficThreshold is the requests per second that prevent a destroy.
+	ActiveTrafficThreshold types.Int64 `tfsdk:"active_traffic_threshold"`
+	// ActiveVersionActivatedBy is the ID of the user who activated the active version.
+	ActiveVersionActivatedBy types.String `tfsdk:"active_version_activated_by"`
+	// ActiveVersionCreatedAt is when the active version was created.
+	ActiveVersionCreatedAt types.String `tfsdk:"active_version_created_at"`
+	// ActiveVersionUpdatedAt is when the active version was last updated.
+	ActiveVersionUpdatedAt types.String `tfsdk:"active_version_updated_at"`
+	// AdoptExisting adopts an existing service with the same name on create.
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+	// BotManagement enables the Bot Management product.
+	BotManagement types.Bool `tfsdk:"bot_management"`
+	// BrotliCompression enables the Brotli compression product.
+	BrotliCompression types.Bool `tfsdk:"brotli_compression"`
+	// ClonedVersion is the draft service version modified by the last apply.
+	ClonedVersion types.Int64 `tfsdk:"cloned_version"`
 	// Comment is a description field for the service.
 	Comment types.String `tfsdk:"comment"`
+	// CreatedAt is when the service was created.
+	CreatedAt types.String `tfsdk:"created_at"`
+	// CustomerID is the ID of the customer (account) that owns the service.
+	CustomerID types.String `tfsdk:"customer_id"`
 	// DefaultHost is the default host name for the version.
 	DefaultHost types.String `tfsdk:"default_host"`
 	// DefaultTTL is the default time-to-live (TTL) for the version.
 	DefaultTTL types.Int64 `tfsdk:"default_ttl"`
+	// Dictionaries is a nested map attribute for the dictionaries associated with the service.
+	Dictionaries map[string]Dictionary `tfsdk:"dictionaries"`
 	// Domains is a nested map attribute for the domain(s) associated with the service.
 	Domains map[string]Domain `tfsdk:"domains"`
+	// DomainInspector enables the Domain Inspector product.
+	DomainInspector types.Bool `tfsdk:"domain_inspector"`
+	// Force is a deprecated alias for ForceDestroy.
+	Force types.Bool `tfsdk:"force"`
 	// ForceDestroy ensures a service will be fully deleted upon `terraform destroy`.
 	ForceDestroy types.Bool `tfsdk:"force_destroy"`
 	// ForceRefresh ensures all nested resources will have their state refreshed.
 	ForceRefresh types.Bool `tfsdk:"force_refresh"`
+	// HasUnactivatedChanges indicates the tracked version isn't the active version.
+	HasUnactivatedChanges types.Bool `tfsdk:"has_unactivated_changes"`
+	// HTTP3 enables HTTP/3 (QUIC) support for the version.
+	HTTP3 types.Bool `tfsdk:"http3"`
 	// ID is a unique ID for the service.
 	ID types.String `tfsdk:"id"`
+	// IgnoreServerManagedSettings keeps the API values of unconfigured settings.
+	IgnoreServerManagedSettings types.Bool `tfsdk:"ignore_server_managed_settings"`
+	// ImageOptimizer enables the Image Optimizer product.
+	ImageOptimizer types.Bool `tfsdk:"image_optimizer"`
 	// Imported indicates the resource is being imported.
 	Imported types.Bool `tfsdk:"imported"`
 	// LastActive is the last known active service version.
 	LastActive types.Int64 `tfsdk:"last_active"`
+	// LoggingKafka is a nested map attribute for the Kafka logging endpoint(s) associated with the service.
+	LoggingKafka map[string]LoggingKafka `tfsdk:"logging_kafka"`
+	// LoggingKinesis is a nested map attribute for the Amazon Kinesis logging endpoint(s) associated with the service.
+	LoggingKinesis map[string]LoggingKinesis `tfsdk:"logging_kinesis"`
+	// LockActiveVersion controls whether the activated service version should be locked.
+	LockActiveVersion types.Bool `tfsdk:"lock_active_version"`
 	// Name is the service name.
 	Name types.String `tfsdk:"name"`
+	// OriginInspector enables the Origin Inspector product.
+	OriginInspector types.Bool `tfsdk:"origin_inspector"`
+	// PreventDestroyIfActiveTraffic refuses to destroy a service receiving traffic.
+	PreventDestroyIfActiveTraffic types.Bool `tfsdk:"prevent_destroy_if_active_traffic"`
 	// Reuse will not delete the service upon `terraform destroy`.
 	Reuse types.Bool `tfsdk:"reuse"`
 	// StaleIfError enables serving a stale object if there is an error.
 	StaleIfError types.Bool `tfsdk:"stale_if_error"`
 	// StaleIfErrorTTL is the default time-to-live (TTL) for serving the stale object for the version.
 	StaleIfErrorTTL types.Int64 `tfsdk:"stale_if_error_ttl"`
+	// Timeouts are the maximum durations for the create/update/delete operations.
+	Timeouts *Timeouts `tfsdk:"timeouts"`
+	// UpdatedAt is when the service was last updated.
+	UpdatedAt types.String `tfsdk:"updated_at"`
 	// Version is the latest service version the provider will clone from.
 	Version types.Int64 `tfsdk:"version"`
+	// WaitForDeployment controls whether to wait for an activation to be deployed.
+	WaitForDeployment types.Bool `tfsdk:"wait_for_deployment"`
+	// WebSockets enables WebSockets passthrough for the service.
+	WebSockets types.Bool `tfsdk:"websockets"`
 }
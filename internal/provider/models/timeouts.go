@@ -0,0 +1,21 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Timeouts describes the operation timeouts data model.
+type Timeouts struct {
+	// Activate is the maximum duration to retry a conflicting activation.
+	Activate types.String `tfsdk:"activate"`
+	// ActivatePollInterval is the initial delay between activation retries.
+	ActivatePollInterval types.String `tfsdk:"activate_poll_interval"`
+	// Create is the maximum duration of a create operation.
+	Create types.String `tfsdk:"create"`
+	// Delete is the maximum duration of a delete operation.
+	Delete types.String `tfsdk:"delete"`
+	// Deployment is the maximum duration to wait for a deployment.
+	Deployment types.String `tfsdk:"deployment"`
+	// Update is the maximum duration of an update operation.
+	Update types.String `tfsdk:"update"`
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TLSCertificate describes the resource data model.
+type TLSCertificate struct {
+	// CertificateBlob is the PEM-encoded certificate.
+	CertificateBlob types.String `tfsdk:"certificate_blob"`
+	// CreatedAt is when the certificate was uploaded.
+	CreatedAt types.String `tfsdk:"created_at"`
+	// Domains are the domains (SAN entries) the certificate is valid for.
+	Domains types.Set `tfsdk:"domains"`
+	// ID is a unique ID for the certificate.
+	ID types.String `tfsdk:"id"`
+	// IssuedTo is the hostname the certificate was issued to.
+	IssuedTo types.String `tfsdk:"issued_to"`
+	// Issuer is the certificate authority that issued the certificate.
+	Issuer types.String `tfsdk:"issuer"`
+	// Name is a customizable name for the certificate.
+	Name types.String `tfsdk:"name"`
+	// NotAfter is when the certificate expires.
+	NotAfter types.String `tfsdk:"not_after"`
+	// NotBefore is when the certificate becomes valid.
+	NotBefore types.String `tfsdk:"not_before"`
+	// Replace indicates Fastly recommends replacing the certificate.
+	Replace types.Bool `tfsdk:"replace"`
+	// SerialNumber is the serial number of the certificate.
+	SerialNumber types.String `tfsdk:"serial_number"`
+	// SignatureAlgorithm is the algorithm used to sign the certificate.
+	SignatureAlgorithm types.String `tfsdk:"signature_algorithm"`
+	// UpdatedAt is when the certificate was last updated.
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
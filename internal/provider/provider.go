@@ -4,15 +4,36 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"time"
 
 	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
 	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/datasources"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/computepackage"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/configstoreentries"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/configstoreentry"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/dictionaryitem"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/fanout"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/kvstoreentry"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/purge"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/serviceclone"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/servicepromotion"
 	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/servicevcl"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/tlscertificate"
 )
 
 // Ensure FastlyProvider satisfies various provider interfaces.
@@ -24,10 +45,47 @@ type FastlyProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+	// middleware wraps the API client transport (see helpers.Middleware).
+	middleware []helpers.Middleware
 }
 
 // FastlyProviderModel describes the provider data model.
-type FastlyProviderModel struct{}
+type FastlyProviderModel struct {
+	// APITiming controls the reporting of API call timings.
+	APITiming types.String `tfsdk:"api_timing"`
+	// CustomerID is the Fastly customer (account) the API token must belong to.
+	CustomerID types.String `tfsdk:"customer_id"`
+	// HTTPTransport configures connection pooling for the API client.
+	HTTPTransport *FastlyProviderHTTPTransportModel `tfsdk:"http_transport"`
+	// MaxRetries is the number of retries for idempotent API calls that fail
+	// because of a transient network error.
+	MaxRetries types.Int64 `tfsdk:"max_retries"`
+	// OTLPEndpoint is the base URL of the OpenTelemetry collector spans are exported to.
+	OTLPEndpoint types.String `tfsdk:"otlp_endpoint"`
+	// ValidateOnly refuses any API call that would make a change.
+	ValidateOnly types.Bool `tfsdk:"validate_only"`
+	// WarnDuplicateServiceNames enables a plan-time check for services that
+	// already use the configured service name.
+	WarnDuplicateServiceNames types.Bool `tfsdk:"warn_duplicate_service_names"`
+}
+
+// FastlyProviderHTTPTransportModel describes the HTTP transport data model.
+type FastlyProviderHTTPTransportModel struct {
+	// CABundle are PEM encoded certificate authorities to trust in addition to the system pool.
+	CABundle types.String `tfsdk:"ca_bundle"`
+	// HTTP2 controls whether HTTP/2 can be negotiated.
+	HTTP2 types.Bool `tfsdk:"http2"`
+	// IdleConnectionTimeout is the number of seconds an idle connection is kept.
+	IdleConnectionTimeout types.Int64 `tfsdk:"idle_connection_timeout"`
+	// KeepAlive controls whether connections are reused.
+	KeepAlive types.Bool `tfsdk:"keep_alive"`
+	// MaxIdleConnections is the maximum number of idle connections.
+	MaxIdleConnections types.Int64 `tfsdk:"max_idle_connections"`
+	// MaxIdleConnectionsPerHost is the maximum number of idle connections per host.
+	MaxIdleConnectionsPerHost types.Int64 `tfsdk:"max_idle_connections_per_host"`
+	// TLSMinVersion is the minimum TLS version.
+	TLSMinVersion types.String `tfsdk:"tls_min_version"`
+}
 
 func (p *FastlyProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "fastly"
@@ -37,7 +95,91 @@ func (p *FastlyProvider) Metadata(_ context.Context, _ provider.MetadataRequest,
 func (p *FastlyProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			// N/A
+			"api_timing": schema.StringAttribute{
+				MarkdownDescription: "Records the number of calls and latency for each Fastly API endpoint during a resource operation. Set to `log` to log a summary (at the `DEBUG` log level) or `warn` to also display the summary as a warning. Disabled by default",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(helpers.APITimingLog), string(helpers.APITimingWarn)),
+				},
+			},
+			"customer_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Fastly customer (account) the API token must belong to. When set, the provider verifies the token's account before planning or applying any changes, preventing accidental changes to the wrong account",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"http_transport": schema.SingleNestedAttribute{
+				MarkdownDescription: "Configures connection pooling and TLS for the HTTP transport used to call the Fastly API. Proxies are configured with the standard `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"ca_bundle": schema.StringAttribute{
+						MarkdownDescription: "PEM encoded certificate authorities to trust in addition to the system certificate pool (e.g. `file(\"proxy-ca.pem\")`). Required when a TLS-intercepting proxy re-signs the Fastly API certificate",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"http2": schema.BoolAttribute{
+						MarkdownDescription: "Allow HTTP/2 to be negotiated. Disable if a proxy doesn't support HTTP/2. Default `true`",
+						Optional:            true,
+					},
+					"idle_connection_timeout": schema.Int64Attribute{
+						MarkdownDescription: "The number of seconds an idle connection is kept in the pool. Default `90`",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"keep_alive": schema.BoolAttribute{
+						MarkdownDescription: "Reuse connections between API calls. Default `true`",
+						Optional:            true,
+					},
+					"max_idle_connections": schema.Int64Attribute{
+						MarkdownDescription: "The maximum number of idle connections kept in the pool. Default `100`",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"max_idle_connections_per_host": schema.Int64Attribute{
+						MarkdownDescription: "The maximum number of idle connections kept in the pool for the Fastly API host. Increase this when applying many resources in parallel. Default `2`",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"tls_min_version": schema.StringAttribute{
+						MarkdownDescription: "The minimum TLS version used to connect to the Fastly API (`1.2` or `1.3`). Default `1.2`",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("1.2", "1.3"),
+						},
+					},
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The number of times an idempotent API call (`GET`, `DELETE` and the `PUT` requests that are safe to repeat, which excludes cloning a service version) is retried when it fails because of a transient network error, such as a connection reset or timeout. Set to `0` to disable retries. Default `%d`", helpers.DefaultMaxRetries),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+			},
+			"otlp_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The base URL of an OpenTelemetry collector (e.g. `http://localhost:4318`) to export traces to using OTLP/HTTP. Each resource and data source operation, and every Fastly API call, is exported as a span to the `/v1/traces` path. The spans join the trace of the W3C `" + helpers.TraceParentEnv + "` environment variable, if it's set. Headers (e.g. for authentication) are read from the `" + helpers.OTLPHeadersEnv + "` environment variable. Defaults to the `" + helpers.OTLPEndpointEnv + "` environment variable (tracing is disabled if neither is set)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an http:// or https:// URL"),
+				},
+			},
+			"validate_only": schema.BoolAttribute{
+				MarkdownDescription: "Refuses every Fastly API call that would make a change (e.g. creating, cloning or activating a service version), while still reading resources and running the plan-time validations (e.g. the API token scope). An apply that would change a resource fails before the change is made. Useful as a safety net when validating a configuration in CI. Default `false`",
+				Optional:            true,
+			},
+			"warn_duplicate_service_names": schema.BoolAttribute{
+				MarkdownDescription: "Lists the services available to the account when planning a new (or renamed) service, and warns if another service already uses the same `name`. The Fastly API allows duplicate service names, but they're a common source of confusion. Default `false`",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -51,30 +193,141 @@ func (p *FastlyProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	maxRetries := helpers.DefaultMaxRetries
+	if !data.MaxRetries.IsNull() {
+		maxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	var transportOpts helpers.HTTPTransportOptions
+	if t := data.HTTPTransport; t != nil {
+		transportOpts = helpers.HTTPTransportOptions{
+			DisableHTTP2:        !t.HTTP2.IsNull() && !t.HTTP2.ValueBool(),
+			DisableKeepAlives:   !t.KeepAlive.IsNull() && !t.KeepAlive.ValueBool(),
+			IdleConnTimeout:     time.Duration(t.IdleConnectionTimeout.ValueInt64()) * time.Second,
+			MaxIdleConns:        int(t.MaxIdleConnections.ValueInt64()),
+			MaxIdleConnsPerHost: int(t.MaxIdleConnectionsPerHost.ValueInt64()),
+		}
+
+		if !t.TLSMinVersion.IsNull() {
+			v, err := helpers.TLSVersion(t.TLSMinVersion.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("http_transport").AtName("tls_min_version"), helpers.ErrorUser, err.Error())
+				return
+			}
+			transportOpts.MinTLSVersion = v
+		}
+
+		if !t.CABundle.IsNull() {
+			pool, err := helpers.CertPool(t.CABundle.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("http_transport").AtName("ca_bundle"), helpers.ErrorUser, fmt.Sprintf("Unable to read the CA bundle: %s", err))
+				return
+			}
+			transportOpts.RootCAs = pool
+		}
+	}
+
 	// Client configuration for data sources and resources
+	//
+	// NOTE: The same transport (and so connection pool) is shared by all
+	// resources and data sources.
+	//
+	// NOTE: Any middleware wraps the underlying HTTP transport, and so is called
+	// for every attempt of an API call (including retries).
+	middleware := p.middleware
+	if data.ValidateOnly.ValueBool() {
+		// NOTE: The validate-only middleware is the outermost, so any other
+		// middleware never sees a request that would make a change.
+		middleware = append([]helpers.Middleware{helpers.ValidateOnlyMiddleware}, middleware...)
+		resp.Diagnostics.AddWarning(
+			"Validate-Only Mode",
+			"The provider's validate_only attribute is enabled, so an apply that would change a resource will fail before the change is made.",
+		)
+	}
+
+	otlpEndpoint := data.OTLPEndpoint.ValueString()
+	if otlpEndpoint == "" {
+		otlpEndpoint = os.Getenv(helpers.OTLPEndpointEnv)
+	}
+	tracer := helpers.NewTracer(otlpEndpoint, p.version)
+
+	// NOTE: The tracing transport is outside of the retry transport, so a
+	// retried API call is recorded as a single span.
 	cfg := fastly.NewConfiguration()
-	client := fastly.NewAPIClient(cfg)
+	cfg.HTTPClient = &http.Client{
+		Transport: helpers.NewConditionalTransport(&helpers.TimingTransport{
+			Transport: &helpers.TracingTransport{
+				Tracer:    tracer,
+				Transport: helpers.NewRetryTransport(helpers.Chain(helpers.NewHTTPTransport(transportOpts), middleware...), maxRetries),
+			},
+		}),
+	}
+
+	providerData := &helpers.ProviderData{
+		APITiming:                 helpers.APITiming(data.APITiming.ValueString()),
+		Client:                    fastly.NewAPIClient(cfg),
+		Token:                     &helpers.TokenInfo{},
+		Tracer:                    tracer,
+		WarnDuplicateServiceNames: data.WarnDuplicateServiceNames.ValueBool(),
+	}
+
+	// NOTE: The customer ID might be unknown until it's applied (e.g. it's
+	// derived from another resource), in which case it can't be verified yet.
+	if !data.CustomerID.IsNull() && !data.CustomerID.IsUnknown() {
+		api := helpers.API{
+			Client:    providerData.Client,
+			ClientCtx: fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv),
+		}
+		providerData.Token.CheckCustomer(ctx, api, data.CustomerID.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 func (p *FastlyProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		computepackage.NewResource(),
+		configstoreentries.NewResource(),
+		configstoreentry.NewResource(),
+		dictionaryitem.NewResource(),
+		fanout.NewResource(),
+		kvstoreentry.NewResource(),
+		purge.NewResource(),
+		serviceclone.NewResource(),
+		servicepromotion.NewResource(),
 		servicevcl.NewResource(),
+		tlscertificate.NewResource(),
 	}
 }
 
 func (p *FastlyProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		datasources.NewDynamicSnippet,
 		datasources.NewExample,
+		datasources.NewKVStores,
+		datasources.NewSecretStoreClientKey,
+		datasources.NewServiceStats,
+		datasources.NewStats,
+		datasources.NewTLSActivation,
+		datasources.NewTLSCertificate,
+		datasources.NewUsage,
+		datasources.NewVCLBoilerplate,
 	}
 }
 
-func New(version string) func() provider.Provider {
+// New returns a provider constructor.
+//
+// The middleware is applied to the API client used by all resources (including
+// nested resources) and data sources.
+func New(version string, middleware ...helpers.Middleware) func() provider.Provider {
 	return func() provider.Provider {
 		return &FastlyProvider{
-			version: version,
+			middleware: middleware,
+			version:    version,
 		}
 	}
 }
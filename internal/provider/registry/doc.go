@@ -0,0 +1,2 @@
+// Package registry holds the nested resources shared by the service resources.
+package registry
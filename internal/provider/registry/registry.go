@@ -0,0 +1,100 @@
+package registry
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/interfaces"
+)
+
+// NestedResource describes a nested resource (e.g. domains) to the service
+// resources that support it.
+type NestedResource struct {
+	// Attribute is the name of the top-level service attribute managed by the
+	// nested resource (e.g. `domains`). It must match interfaces.Resource.Attribute.
+	Attribute string
+	// New returns a new instance of the nested resource.
+	//
+	// NOTE: A new instance is required for each service resource instance, as
+	// the nested resource records the changes it detects.
+	New func() interfaces.Resource
+	// Schema returns the schema of the top-level service attribute.
+	Schema func() schema.Attribute
+	// ServiceTypes are the service types that support the nested resource.
+	// All service types are supported if empty.
+	ServiceTypes []helpers.ServiceType
+}
+
+// supports indicates if the nested resource supports the service type.
+func (n NestedResource) supports(serviceType helpers.ServiceType) bool {
+	return len(n.ServiceTypes) == 0 || slices.Contains(n.ServiceTypes, serviceType)
+}
+
+var registered = struct {
+	mu        sync.Mutex
+	resources map[string]NestedResource
+}{resources: make(map[string]NestedResource)}
+
+// Register adds a nested resource to the registry. It's expected to be called
+// from the init function of the nested resource package.
+//
+// It panics if the nested resource is incomplete, or if another nested
+// resource has already registered the same attribute, as either is a
+// programming error.
+func Register(n NestedResource) {
+	if n.Attribute == "" || n.New == nil || n.Schema == nil {
+		panic(fmt.Sprintf("registry: incomplete nested resource %q", n.Attribute))
+	}
+
+	registered.mu.Lock()
+	defer registered.mu.Unlock()
+
+	if _, exists := registered.resources[n.Attribute]; exists {
+		panic(fmt.Sprintf("registry: nested resource %q is already registered", n.Attribute))
+	}
+	registered.resources[n.Attribute] = n
+}
+
+// NestedResources returns new instances of the nested resources supported by
+// the service type, ordered by their attribute name (so the API calls are
+// made in a consistent order).
+func NestedResources(serviceType helpers.ServiceType) []interfaces.Resource {
+	var resources []interfaces.Resource
+	for _, n := range supported(serviceType) {
+		resources = append(resources, n.New())
+	}
+	return resources
+}
+
+// Schemas returns the schema attributes of the nested resources supported by
+// the service type, keyed by their attribute name.
+func Schemas(serviceType helpers.ServiceType) map[string]schema.Attribute {
+	attrs := make(map[string]schema.Attribute)
+	for _, n := range supported(serviceType) {
+		attrs[n.Attribute] = n.Schema()
+	}
+	return attrs
+}
+
+// supported returns the nested resources supported by the service type,
+// ordered by their attribute name.
+func supported(serviceType helpers.ServiceType) []NestedResource {
+	registered.mu.Lock()
+	defer registered.mu.Unlock()
+
+	var resources []NestedResource
+	for _, n := range registered.resources {
+		if n.supports(serviceType) {
+			resources = append(resources, n)
+		}
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Attribute < resources[j].Attribute
+	})
+	return resources
+}
@@ -0,0 +1,53 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/interfaces"
+)
+
+func TestRegistry(t *testing.T) {
+	// NOTE: The registry is global, so the test restores it when finished.
+	saved := registered.resources
+	registered.resources = make(map[string]NestedResource)
+	t.Cleanup(func() { registered.resources = saved })
+
+	newResource := func() interfaces.Resource { return nil }
+	newSchema := func() schema.Attribute { return schema.StringAttribute{Optional: true} }
+
+	Register(NestedResource{Attribute: "snippets", New: newResource, Schema: newSchema, ServiceTypes: []helpers.ServiceType{helpers.ServiceTypeVCL}})
+	Register(NestedResource{Attribute: "backends", New: newResource, Schema: newSchema})
+
+	if got := len(NestedResources(helpers.ServiceTypeVCL)); got != 2 {
+		t.Errorf("want 2 nested resources for a VCL service, got %d", got)
+	}
+	if got := len(NestedResources(helpers.ServiceTypeWasm)); got != 1 {
+		t.Errorf("want 1 nested resource for a Compute service, got %d", got)
+	}
+
+	attrs := Schemas(helpers.ServiceTypeWasm)
+	if _, ok := attrs["backends"]; !ok || len(attrs) != 1 {
+		t.Errorf("want only the backends schema for a Compute service, got %v", attrs)
+	}
+
+	if got := supported(helpers.ServiceTypeVCL); got[0].Attribute != "backends" || got[1].Attribute != "snippets" {
+		t.Errorf("want the nested resources ordered by attribute, got %v", got)
+	}
+
+	for name, n := range map[string]NestedResource{
+		"duplicate":  {Attribute: "backends", New: newResource, Schema: newSchema},
+		"incomplete": {Attribute: "headers"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected Register to panic")
+				}
+			}()
+			Register(n)
+		})
+	}
+}
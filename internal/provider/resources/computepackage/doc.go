@@ -0,0 +1,2 @@
+// Package computepackage implements a Compute package upload resource.
+package computepackage
@@ -0,0 +1,80 @@
+package computepackage
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Create is called when the provider must create a new resource.
+// Config and planned state values should be read from the CreateRequest.
+// New state values set on the CreateResponse.
+//
+// Creating the resource uploads the package.
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	api, finishSpan := r.newAPI(ctx, "Create")
+	defer finishSpan(&resp.Diagnostics)
+
+	var plan *models.Package
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after plan population")
+		return
+	}
+
+	if err := r.uploadPackage(ctx, api, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%d", plan.ServiceID.ValueString(), plan.Version.ValueInt64()))
+
+	// Save the planned changes into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Create", map[string]any{"state": helpers.LogState(plan)})
+}
+
+// uploadPackage uploads the package file and updates the computed attributes.
+func (r *Resource) uploadPackage(ctx context.Context, api helpers.API, plan *models.Package, diags *diag.Diagnostics) error {
+	f, err := os.Open(plan.Filename.ValueString())
+	if err != nil {
+		diags.AddError(helpers.ErrorUser, fmt.Sprintf("Unable to open package file, got error: %s", err))
+		return err
+	}
+	defer f.Close()
+
+	serviceID := plan.ServiceID.ValueString()
+	serviceVersion := int32(plan.Version.ValueInt64())
+
+	clientReq := api.Client.PackageAPI.PutPackage(api.ClientCtx, serviceID, serviceVersion)
+	clientReq.ComputePackage(f)
+
+	clientResp, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly PackageAPI.PutPackage error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, fmt.Sprintf("Unable to upload package to service version %d", serviceVersion))
+		return err
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return err
+	}
+
+	setPackageMetadata(plan, clientResp.GetMetadata())
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package computepackage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Delete is called when the provider must delete the resource.
+// Config values may be read from the DeleteRequest.
+//
+// The API doesn't support deleting a package (it is replaced by the next
+// upload), so deleting only removes it from the state, which the framework
+// does automatically when execution completes without error.
+func (r *Resource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete: package removed from state")
+}
@@ -0,0 +1,71 @@
+package computepackage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Read is called when the provider must read resource values in order to update state.
+// Planned state values should be read from the ReadRequest.
+// New state values set on the ReadResponse.
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	api, finishSpan := r.newAPI(ctx, "Read")
+	defer finishSpan(&resp.Diagnostics)
+
+	var state *models.Package
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after state population")
+		return
+	}
+
+	serviceID := state.ServiceID.ValueString()
+	serviceVersion := int32(state.Version.ValueInt64())
+
+	clientReq := api.Client.PackageAPI.GetPackage(api.ClientCtx, serviceID, serviceVersion)
+	clientResp, httpResp, err := clientReq.Execute()
+	if err != nil {
+		// The service (or version) doesn't have a package, so we remove it from
+		// the state and the next plan will upload it again.
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, "Fastly package not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		tflog.Trace(ctx, "Fastly PackageAPI.GetPackage error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, fmt.Sprintf("Unable to read package for service version %d", serviceVersion))
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	setPackageMetadata(state, clientResp.GetMetadata())
+
+	// Save the updated state data back into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Read", map[string]any{"state": helpers.LogState(state)})
+}
+
+// setPackageMetadata populates the computed attributes from the package metadata.
+func setPackageMetadata(data *models.Package, metadata fastly.PackageMetadata) {
+	data.FilesHash = types.StringValue(metadata.GetFilesHash())
+	data.Hashsum = types.StringValue(metadata.GetHashsum())
+	data.Language = types.StringValue(metadata.GetLanguage())
+	data.Name = types.StringValue(metadata.GetName())
+	data.Size = types.Int64Value(int64(metadata.GetSize()))
+}
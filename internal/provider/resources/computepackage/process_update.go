@@ -0,0 +1,58 @@
+package computepackage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Update is called to update the state of the resource.
+// Config, planned state, and prior state values should be read from the UpdateRequest.
+// New state values set on the UpdateResponse.
+//
+// Only `filename` and `source_code_hash` can change in-place, and a change to
+// either of them uploads the package again to the same service version. If only
+// `filename` has changed, and the file is identical to the uploaded package
+// (see fileHash), the upload is skipped.
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	api, finishSpan := r.newAPI(ctx, "Update")
+	defer finishSpan(&resp.Diagnostics)
+
+	var plan *models.Package
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after plan population")
+		return
+	}
+
+	var state *models.Package
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hash, err := fileHash(plan.Filename.ValueString())
+	if err == nil && hash == state.Hashsum.ValueString() && plan.SourceCodeHash.Equal(state.SourceCodeHash) {
+		tflog.Debug(ctx, "Package is unchanged, skipping upload", map[string]any{"hashsum": hash})
+		plan.FilesHash = state.FilesHash
+		plan.Hashsum = state.Hashsum
+		plan.Language = state.Language
+		plan.Name = state.Name
+		plan.Size = state.Size
+	} else if err := r.uploadPackage(ctx, api, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	// Save the planned changes into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Update", map[string]any{"state": helpers.LogState(plan)})
+}
@@ -0,0 +1,260 @@
+package computepackage
+
+import (
+	"context"
+	"crypto/sha512"
+	_ "embed"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+//go:embed docs/package.md
+var resourceDescription string
+
+// Ensure provider defined types fully satisfy framework interfaces.
+//
+// https://pkg.go.dev/github.com/hashicorp/terraform-plugin-framework/resource#Resource
+// https://pkg.go.dev/github.com/hashicorp/terraform-plugin-framework/resource#ResourceWithConfigure
+// https://pkg.go.dev/github.com/hashicorp/terraform-plugin-framework/resource#ResourceWithImportState
+// https://pkg.go.dev/github.com/hashicorp/terraform-plugin-framework/resource#ResourceWithModifyPlan
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithConfigure   = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithModifyPlan  = &Resource{}
+)
+
+// NewResource returns a new Terraform resource instance.
+func NewResource() func() resource.Resource {
+	return func() resource.Resource {
+		return &Resource{}
+	}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	// client is a preconfigured instance of the Fastly API client.
+	client *fastly.APIClient
+	// clientCtx contains the user's API token.
+	clientCtx context.Context
+	// token describes the user's API token.
+	token *helpers.TokenInfo
+	// tracer exports the spans of each operation (nil if tracing is disabled).
+	tracer *helpers.Tracer
+}
+
+// Metadata should return the full name of the resource.
+func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_package"
+}
+
+// Schema should return the schema for this resource.
+func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: resourceDescription,
+
+		// Attributes is the mapping of underlying attribute names to attribute definitions.
+		Attributes: map[string]schema.Attribute{
+			"filename": schema.StringAttribute{
+				MarkdownDescription: "The path to the Compute package (`.tar.gz`) to upload",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"files_hash": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "A hash of the files within the uploaded package",
+			},
+			"hashsum": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "A hash of the uploaded package",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The service ID and version the package was uploaded to, separated by a forward slash",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"language": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The language of the uploaded package",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the uploaded package",
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Compute service to upload the package to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The size of the uploaded package in bytes",
+			},
+			"source_code_hash": schema.StringAttribute{
+				MarkdownDescription: "Used to trigger a new upload when the package content changes (e.g. `filesha512(\"pkg/example.tar.gz\")`)",
+				Optional:            true,
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "The service version to upload the package to. The version must not be active or locked",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure includes provider-level data or clients.
+func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*helpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.clientCtx = fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv)
+	r.token = providerData.Token
+	r.tracer = providerData.Tracer
+}
+
+// newAPI returns the API helper to use for a single CRUD operation, and a
+// function that should be deferred to export the operation's span.
+func (r *Resource) newAPI(ctx context.Context, operation string) (helpers.API, func(diags *diag.Diagnostics)) {
+	return r.tracer.Operation(ctx, helpers.API{Client: r.client, ClientCtx: r.clientCtx}, "fastly_package."+operation)
+}
+
+// ImportState is called when the provider must import the state of a resource instance.
+//
+// The ID must be the service ID and version separated by a forward slash.
+// e.g. `terraform import fastly_package.example SU1Z0isxPaozGVKXdv0eY/2`
+//
+// NOTE: The `filename` can't be known, so the next plan will upload the package.
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceID, version, found := strings.Cut(req.ID, "/")
+	v, err := strconv.ParseInt(version, 10, 64)
+	if !found || serviceID == "" || err != nil {
+		resp.Diagnostics.AddError(helpers.ErrorUser, fmt.Sprintf("Expected an import ID of the form SERVICE_ID/VERSION, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), types.Int64Value(v))...)
+}
+
+// ModifyPlan checks the API token is permitted to manage the resource, so a
+// token without the required scope fails the plan rather than the apply.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var serviceID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("service_id"), &serviceID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, finishSpan := r.newAPI(ctx, "ModifyPlan")
+	defer finishSpan(&resp.Diagnostics)
+
+	r.token.CheckScope(ctx, api, "fastly_package", serviceID.ValueString(), helpers.PlanHasChanges(req.Plan.Raw, req.State.Raw), &resp.Diagnostics, helpers.ScopeGlobal)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The resource is being created.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var filename, hashsum types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("filename"), &filename)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("hashsum"), &hashsum)...)
+	if resp.Diagnostics.HasError() || filename.IsUnknown() {
+		return
+	}
+
+	// If the package file differs from the uploaded package (e.g. the package
+	// was uploaded outside of Terraform), the package metadata is marked as
+	// unknown so the package is uploaded again.
+	//
+	// NOTE: The file might not exist when planning (e.g. it's built during the
+	// apply), in which case only a change to `filename` or `source_code_hash`
+	// uploads the package.
+	hash, err := fileHash(filename.ValueString())
+	if err != nil || hash == hashsum.ValueString() {
+		return
+	}
+
+	tflog.Debug(ctx, "Package file differs from the uploaded package", map[string]any{"hashsum": hashsum.ValueString(), "file_hash": hash})
+
+	for _, name := range []string{"files_hash", "hashsum", "language", "name", "size"} {
+		var unknown attr.Value = types.StringUnknown()
+		if name == "size" {
+			unknown = types.Int64Unknown()
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), unknown)...)
+	}
+}
+
+// fileHash returns the SHA-512 hash of the file (hex encoded).
+//
+// NOTE: The API reports the SHA-512 hash of an uploaded package as its
+// `hashsum`, so the hashes can be compared to detect a changed package.
+func fileHash(filename string) (string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha512.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,28 @@
+package computepackage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileHash(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "package.tar.gz")
+	if err := os.WriteFile(filename, []byte("package"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// printf package | sha512sum
+	want := "6da49bbfa969964dd1c736f128e61ca5dc5388ab1df0c4e2b3e03eb07ff8d9ab63d2ed2fd5aac86c34d2d285997ec85b0f4e4cd92953a049f120f1a09ad6f0da"
+	got, err := fileHash(filename)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != want {
+		t.Errorf("want %s, got: %s", want, got)
+	}
+
+	if _, err := fileHash(filepath.Join(t.TempDir(), "missing.tar.gz")); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
@@ -0,0 +1,2 @@
+// Package configstoreentries implements a resource managing many config store entries.
+package configstoreentries
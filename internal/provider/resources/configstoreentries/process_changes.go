@@ -0,0 +1,52 @@
+package configstoreentries
+
+import (
+	"sort"
+
+	"github.com/fastly/fastly-go/fastly"
+)
+
+// Bulk update operations.
+const (
+	opDelete = "delete"
+	opUpsert = "upsert"
+)
+
+// operations returns the bulk update operations (sorted by key) that make the
+// store's entries match the planned entries.
+//
+// UPSERTED:
+// If a planned key doesn't exist in the store, or its value differs, then the
+// entry is upserted.
+//
+// DELETED:
+// If a key in the store isn't planned, and is owned by Terraform, then the
+// entry is deleted. Keys not owned by Terraform are left as they are.
+func operations(plan, store map[string]string, owned func(key string) bool) []fastly.BulkUpdateConfigStoreItem {
+	ops := make([]fastly.BulkUpdateConfigStoreItem, 0)
+
+	for key, value := range plan {
+		if current, ok := store[key]; !ok || current != value {
+			op := fastly.NewBulkUpdateConfigStoreItem()
+			op.SetItemKey(key)
+			op.SetItemValue(value)
+			op.SetOp(opUpsert)
+			ops = append(ops, *op)
+		}
+	}
+
+	for key := range store {
+		if _, ok := plan[key]; !ok && owned(key) {
+			op := fastly.NewBulkUpdateConfigStoreItem()
+			op.SetItemKey(key)
+			op.SetOp(opDelete)
+			ops = append(ops, *op)
+		}
+	}
+
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].GetItemKey() < ops[j].GetItemKey()
+	})
+
+	return ops
+}
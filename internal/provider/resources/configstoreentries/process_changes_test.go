@@ -0,0 +1,60 @@
+package configstoreentries
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fastly/fastly-go/fastly"
+)
+
+// summarise returns the operations as "op:key" strings.
+func summarise(ops []fastly.BulkUpdateConfigStoreItem) []string {
+	summary := make([]string, 0, len(ops))
+	for _, op := range ops {
+		summary = append(summary, op.GetOp()+":"+op.GetItemKey())
+	}
+	return summary
+}
+
+func TestOperations(t *testing.T) {
+	plan := map[string]string{"added": "1", "changed": "2", "unchanged": "3"}
+	store := map[string]string{"changed": "old", "unchanged": "3", "removed": "4", "unmanaged": "5"}
+
+	tests := map[string]struct {
+		owned func(string) bool
+		want  []string
+	}{
+		"only the keys owned by Terraform": {
+			owned: func(key string) bool { return key == "removed" },
+			want:  []string{"upsert:added", "upsert:changed", "delete:removed"},
+		},
+		"every key (manage_items)": {
+			owned: func(string) bool { return true },
+			want:  []string{"upsert:added", "upsert:changed", "delete:removed", "delete:unmanaged"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := summarise(operations(plan, store, tc.owned)); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestOperationsUnchanged(t *testing.T) {
+	entries := map[string]string{"key": "value"}
+
+	if ops := operations(entries, entries, func(string) bool { return true }); len(ops) != 0 {
+		t.Errorf("expected no operations, got %v", summarise(ops))
+	}
+}
+
+func TestOperationsUpsertValue(t *testing.T) {
+	ops := operations(map[string]string{"key": "new"}, map[string]string{"key": "old"}, func(string) bool { return true })
+
+	if len(ops) != 1 || ops[0].GetItemValue() != "new" {
+		t.Errorf("expected the new value to be upserted, got %+v", ops)
+	}
+}
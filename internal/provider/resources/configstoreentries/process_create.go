@@ -0,0 +1,53 @@
+package configstoreentries
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Create is called when the provider must create a new resource.
+// Config and planned state values should be read from the CreateRequest.
+// New state values set on the CreateResponse.
+//
+// NOTE: An existing entry with a planned key is overwritten. If `manage_items`
+// is `true`, every other entry in the store is deleted.
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	api, finishSpan := r.newAPI(ctx, "Create")
+	defer finishSpan(&resp.Diagnostics)
+
+	var plan *models.ConfigStoreEntries
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after plan population")
+		return
+	}
+
+	var entries map[string]string
+	resp.Diagnostics.Append(plan.Entries.ElementsAs(ctx, &entries, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owned := func(string) bool { return plan.ManageItems.ValueBool() }
+	if err := r.sync(ctx, api, plan.StoreID.ValueString(), entries, owned, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	plan.ID = plan.StoreID
+
+	// Save the planned changes into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Create", map[string]any{"state": helpers.LogState(plan)})
+}
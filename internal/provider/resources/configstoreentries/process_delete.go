@@ -0,0 +1,57 @@
+package configstoreentries
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Delete is called when the provider must delete the resource.
+// Config values may be read from the DeleteRequest.
+//
+// Only the entries owned by Terraform are deleted (every entry if
+// `manage_items` is `true`). The store itself isn't deleted.
+//
+// If execution completes without error, the framework will automatically call
+// DeleteResponse.State.RemoveResource().
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	api, finishSpan := r.newAPI(ctx, "Delete")
+	defer finishSpan(&resp.Diagnostics)
+
+	var state *models.ConfigStoreEntries
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after state population")
+		return
+	}
+
+	var entries map[string]string
+	resp.Diagnostics.Append(state.Entries.ElementsAs(ctx, &entries, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The store was already deleted outside of Terraform.
+	items, found, err := r.items(ctx, api, state.StoreID.ValueString(), &resp.Diagnostics)
+	if err != nil || !found {
+		return
+	}
+
+	owned := func(key string) bool {
+		_, ok := entries[key]
+		return ok || state.ManageItems.ValueBool()
+	}
+	if err := r.bulkUpdate(ctx, api, state.StoreID.ValueString(), operations(nil, items, owned), &resp.Diagnostics); err != nil {
+		return
+	}
+
+	tflog.Debug(ctx, "Delete", map[string]any{"state": helpers.LogState(state)})
+}
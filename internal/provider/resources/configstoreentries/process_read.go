@@ -0,0 +1,99 @@
+package configstoreentries
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Read is called when the provider must read resource values in order to update state.
+// Planned state values should be read from the ReadRequest.
+// New state values set on the ReadResponse.
+//
+// If `manage_items` is `true` (or the resource was imported) every entry in
+// the store is read, otherwise only the entries with a key in the state.
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	api, finishSpan := r.newAPI(ctx, "Read")
+	defer finishSpan(&resp.Diagnostics)
+
+	var state *models.ConfigStoreEntries
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after state population")
+		return
+	}
+
+	items, found, err := r.items(ctx, api, state.StoreID.ValueString(), &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	// The store was deleted outside of Terraform.
+	if !found {
+		tflog.Warn(ctx, "Fastly config store not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if !state.ManageItems.ValueBool() && !state.Entries.IsNull() {
+		managed := make(map[string]string)
+		resp.Diagnostics.Append(state.Entries.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for key := range managed {
+			if value, ok := items[key]; ok {
+				managed[key] = value
+			} else {
+				delete(managed, key)
+			}
+		}
+		items = managed
+	}
+
+	entries, d := types.MapValueFrom(ctx, types.StringType, items)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Entries = entries
+
+	// Save the updated state data back into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Read", map[string]any{"state": helpers.LogState(state)})
+}
+
+// items returns every entry in the store (a map of the keys to their values).
+// It returns false if the store doesn't exist.
+func (r *Resource) items(ctx context.Context, api helpers.API, storeID string, diags *diag.Diagnostics) (map[string]string, bool, error) {
+	clientReq := api.Client.ConfigStoreItemAPI.ListConfigStoreItems(api.ClientCtx, storeID)
+	clientResp, httpResp, err := clientReq.Execute()
+	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			return nil, false, nil
+		}
+		tflog.Trace(ctx, "Fastly ConfigStoreItemAPI.ListConfigStoreItems error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to list config store entries")
+		return nil, false, err
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return nil, false, err
+	}
+
+	items := make(map[string]string, len(clientResp))
+	for _, item := range clientResp {
+		items[item.GetItemKey()] = item.GetItemValue()
+	}
+	return items, true, nil
+}
@@ -0,0 +1,98 @@
+package configstoreentries
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Update is called to update the state of the resource.
+// Config, planned state, and prior state values should be read from the UpdateRequest.
+// New state values set on the UpdateResponse.
+//
+// Only the changed entries are written. An entry removed from `entries` is
+// deleted, as its key was owned by Terraform.
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	api, finishSpan := r.newAPI(ctx, "Update")
+	defer finishSpan(&resp.Diagnostics)
+
+	var plan, state *models.ConfigStoreEntries
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan == nil || state == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after plan population")
+		return
+	}
+
+	var planEntries, stateEntries map[string]string
+	resp.Diagnostics.Append(plan.Entries.ElementsAs(ctx, &planEntries, false)...)
+	resp.Diagnostics.Append(state.Entries.ElementsAs(ctx, &stateEntries, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owned := func(key string) bool {
+		_, ok := stateEntries[key]
+		return ok || plan.ManageItems.ValueBool()
+	}
+	if err := r.sync(ctx, api, plan.StoreID.ValueString(), planEntries, owned, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	// Save the planned changes into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Update", map[string]any{"state": helpers.LogState(plan)})
+}
+
+// sync makes the store's entries match the planned entries, deleting the
+// unplanned entries that are owned by Terraform, using a single bulk update.
+func (r *Resource) sync(ctx context.Context, api helpers.API, storeID string, plan map[string]string, owned func(key string) bool, diags *diag.Diagnostics) error {
+	items, found, err := r.items(ctx, api, storeID, diags)
+	if err != nil {
+		return err
+	}
+	if !found {
+		err := errors.New("config store not found")
+		diags.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to update config store entries, got error: %s", err))
+		return err
+	}
+
+	return r.bulkUpdate(ctx, api, storeID, operations(plan, items, owned), diags)
+}
+
+// bulkUpdate applies the operations to the store's entries.
+func (r *Resource) bulkUpdate(ctx context.Context, api helpers.API, storeID string, ops []fastly.BulkUpdateConfigStoreItem, diags *diag.Diagnostics) error {
+	tflog.Debug(ctx, "Config store entries", map[string]any{"store_id": storeID, "operations": len(ops)})
+	if len(ops) == 0 {
+		return nil
+	}
+
+	body := fastly.NewBulkUpdateConfigStoreListRequest()
+	body.SetItems(ops)
+
+	clientReq := api.Client.ConfigStoreItemAPI.BulkUpdateConfigStoreItem(api.ClientCtx, storeID)
+	clientReq.BulkUpdateConfigStoreListRequest(*body)
+
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly ConfigStoreItemAPI.BulkUpdateConfigStoreItem error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to update config store entries")
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	return helpers.CheckStatus(ctx, httpResp, diags)
+}
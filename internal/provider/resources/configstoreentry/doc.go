@@ -0,0 +1,2 @@
+// Package configstoreentry implements a single config store entry resource.
+package configstoreentry
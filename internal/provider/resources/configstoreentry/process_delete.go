@@ -0,0 +1,47 @@
+package configstoreentry
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Delete is called when the provider must delete the resource.
+// Config values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically call
+// DeleteResponse.State.RemoveResource().
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	api, finishSpan := r.newAPI(ctx, "Delete")
+	defer finishSpan(&resp.Diagnostics)
+
+	var state *models.ConfigStoreEntry
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after state population")
+		return
+	}
+
+	clientReq := api.Client.ConfigStoreItemAPI.DeleteConfigStoreItem(api.ClientCtx, state.StoreID.ValueString(), state.Key.ValueString())
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		// The entry was already deleted outside of Terraform.
+		if helpers.IsNotFound(httpResp) {
+			return
+		}
+		tflog.Trace(ctx, "Fastly ConfigStoreItemAPI.DeleteConfigStoreItem error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to delete config store entry")
+		return
+	}
+	defer httpResp.Body.Close()
+
+	tflog.Debug(ctx, "Delete", map[string]any{"state": helpers.LogState(state)})
+}
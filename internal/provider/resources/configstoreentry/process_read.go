@@ -0,0 +1,56 @@
+package configstoreentry
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Read is called when the provider must read resource values in order to update state.
+// Planned state values should be read from the ReadRequest.
+// New state values set on the ReadResponse.
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	api, finishSpan := r.newAPI(ctx, "Read")
+	defer finishSpan(&resp.Diagnostics)
+
+	var state *models.ConfigStoreEntry
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after state population")
+		return
+	}
+
+	clientReq := api.Client.ConfigStoreItemAPI.GetConfigStoreItem(api.ClientCtx, state.StoreID.ValueString(), state.Key.ValueString())
+	clientResp, httpResp, err := clientReq.Execute()
+	if err != nil {
+		// The entry was deleted outside of Terraform, so the next plan will recreate it.
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, "Fastly config store entry not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		tflog.Trace(ctx, "Fastly ConfigStoreItemAPI.GetConfigStoreItem error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to read config store entry")
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	state.Value = types.StringValue(clientResp.GetItemValue())
+
+	// Save the updated state data back into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Read", map[string]any{"state": helpers.LogState(state)})
+}
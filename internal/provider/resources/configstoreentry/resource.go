@@ -0,0 +1,163 @@
+package configstoreentry
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+//go:embed docs/config_store_entry.md
+var resourceDescription string
+
+// Ensure provider defined types fully satisfy framework interfaces.
+//
+// https://pkg.go.dev/github.com/hashicorp/terraform-plugin-framework/resource#Resource
+// https://pkg.go.dev/github.com/hashicorp/terraform-plugin-framework/resource#ResourceWithConfigure
+// https://pkg.go.dev/github.com/hashicorp/terraform-plugin-framework/resource#ResourceWithImportState
+// https://pkg.go.dev/github.com/hashicorp/terraform-plugin-framework/resource#ResourceWithModifyPlan
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithConfigure   = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithModifyPlan  = &Resource{}
+)
+
+// NewResource returns a new Terraform resource instance.
+func NewResource() func() resource.Resource {
+	return func() resource.Resource {
+		return &Resource{}
+	}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	// client is a preconfigured instance of the Fastly API client.
+	client *fastly.APIClient
+	// clientCtx contains the user's API token.
+	clientCtx context.Context
+	// token describes the user's API token.
+	token *helpers.TokenInfo
+	// tracer exports the spans of each operation (nil if tracing is disabled).
+	tracer *helpers.Tracer
+}
+
+// Metadata should return the full name of the resource.
+func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_store_entry"
+}
+
+// Schema should return the schema for this resource.
+func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: resourceDescription,
+
+		// Attributes is the mapping of underlying attribute names to attribute definitions.
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The store ID and entry key, separated by a forward slash",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The config store entry key",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the config store the entry belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The config store entry value",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(8000),
+				},
+			},
+		},
+	}
+}
+
+// Configure includes provider-level data or clients.
+func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*helpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.clientCtx = fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv)
+	r.token = providerData.Token
+	r.tracer = providerData.Tracer
+}
+
+// newAPI returns the API helper to use for a single CRUD operation, and a
+// function that should be deferred to export the operation's span.
+func (r *Resource) newAPI(ctx context.Context, operation string) (helpers.API, func(diags *diag.Diagnostics)) {
+	return r.tracer.Operation(ctx, helpers.API{Client: r.client, ClientCtx: r.clientCtx}, "fastly_config_store_entry."+operation)
+}
+
+// ImportState is called when the provider must import the state of a resource instance.
+//
+// The ID must be the store ID and entry key separated by a forward slash (the
+// key itself may contain forward slashes).
+// e.g. `terraform import fastly_config_store_entry.example STORE_ID/KEY`
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(helpers.ErrorUser, fmt.Sprintf("Expected an import ID of the form STORE_ID/KEY, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("store_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), parts[1])...)
+}
+
+// ModifyPlan checks the API token is permitted to manage the resource, so a
+// token without the required scope fails the plan rather than the apply.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	api, finishSpan := r.newAPI(ctx, "ModifyPlan")
+	defer finishSpan(&resp.Diagnostics)
+
+	r.token.CheckScope(ctx, api, "fastly_config_store_entry", "", helpers.PlanHasChanges(req.Plan.Raw, req.State.Raw), &resp.Diagnostics, helpers.ScopeGlobal)
+}
@@ -0,0 +1,78 @@
+package dictionary
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/mockapi"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// TestContractDictionary validates a renamed dictionary keeps its ID, and a
+// dictionary whose write_only attribute has changed is recreated (as the API
+// doesn't allow write_only to be updated).
+func TestContractDictionary(t *testing.T) {
+	server, api, serviceID := mockapi.NewService(t)
+
+	service := &helpers.Service{ID: serviceID, Version: 1}
+	dictionaryData := models.Dictionary{
+		Name:      types.StringValue("config"),
+		WriteOnly: types.BoolValue(false),
+	}
+
+	var resp resource.UpdateResponse
+	if err := added(context.Background(), api, service, dictionaryData, &resp); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, resp.Diagnostics)
+	}
+	dictionaries := server.Service(service.ID).Versions[0].Dictionaries
+	if len(dictionaries) != 1 || dictionaries[0].ID == "" {
+		t.Fatalf("expected a dictionary to be created, got %+v", dictionaries)
+	}
+	dictionaryID := dictionaries[0].ID
+
+	dictionaryData.NamePast = dictionaryData.Name
+	dictionaryData.Name = types.StringValue("settings")
+	if err := modified(context.Background(), api, service, dictionaryData, &resp); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, resp.Diagnostics)
+	}
+	if got := server.Service(service.ID).Versions[0].Dictionaries[0]; got.Name != "settings" || got.ID != dictionaryID {
+		t.Errorf("expected the dictionary to be renamed and keep its ID, got %+v", got)
+	}
+
+	r := &Resource{}
+	state := map[string]models.Dictionary{
+		"config": {DictionaryID: types.StringValue(dictionaryID), Name: types.StringValue("settings"), WriteOnly: types.BoolValue(false)},
+	}
+	plan := map[string]*models.Dictionary{
+		"config": {DictionaryID: types.StringUnknown(), Name: types.StringValue("settings"), WriteOnly: types.BoolValue(true)},
+	}
+	r.Changed, r.Added, r.Deleted, r.Modified = changes(plan, state)
+
+	if err := forEachDictionary(r.Deleted, &resp, func(dictionaryData models.Dictionary, resp *resource.UpdateResponse) error {
+		return deleted(context.Background(), api, service, dictionaryData, resp)
+	}); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, resp.Diagnostics)
+	}
+	if err := forEachDictionary(r.Added, &resp, func(dictionaryData models.Dictionary, resp *resource.UpdateResponse) error {
+		return added(context.Background(), api, service, dictionaryData, resp)
+	}); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, resp.Diagnostics)
+	}
+
+	dictionaries = server.Service(service.ID).Versions[0].Dictionaries
+	if len(dictionaries) != 1 || !dictionaries[0].WriteOnly || dictionaries[0].ID == dictionaryID {
+		t.Errorf("expected the dictionary to be recreated as write-only, got %+v", dictionaries)
+	}
+
+	remote, err := read(context.Background(), state, api, service, &resp.Diagnostics)
+	if err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, resp.Diagnostics)
+	}
+	if got := remote["config"]; got.DictionaryID.ValueString() != dictionaries[0].ID || !got.WriteOnly.ValueBool() {
+		t.Errorf("expected the recreated dictionary to be read into the state key, got %+v", remote)
+	}
+}
@@ -0,0 +1,2 @@
+// Package dictionary implements a dictionary resource.
+package dictionary
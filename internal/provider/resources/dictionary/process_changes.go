@@ -0,0 +1,117 @@
+package dictionary
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// InspectChanges checks for configuration changes and persists to data model.
+func (r *Resource) InspectChanges(
+	ctx context.Context,
+	req *resource.UpdateRequest,
+	_ *resource.UpdateResponse,
+	_ helpers.API,
+	_ *helpers.Service,
+) (bool, error) {
+	var planDictionaries map[string]*models.Dictionary // NOTE: Needs to mutate NamePast.
+	var stateDictionaries map[string]models.Dictionary
+
+	req.Plan.GetAttribute(ctx, path.Root("dictionaries"), &planDictionaries)
+	req.State.GetAttribute(ctx, path.Root("dictionaries"), &stateDictionaries)
+
+	r.Changed, r.Added, r.Deleted, r.Modified = changes(planDictionaries, stateDictionaries)
+
+	tflog.Debug(ctx, "Dictionaries", map[string]any{
+		"added":    r.Added,
+		"deleted":  r.Deleted,
+		"modified": r.Modified,
+		"changed":  r.Changed,
+	})
+
+	req.Plan.SetAttribute(ctx, path.Root("dictionaries"), &planDictionaries)
+
+	return r.Changed, nil
+}
+
+// HasChanges indicates if the nested resource contains configuration changes.
+func (r *Resource) HasChanges() bool {
+	return r.Changed
+}
+
+// ChangeCounts returns the number of added, deleted and modified dictionaries.
+func (r *Resource) ChangeCounts() (added, deleted, modified int) {
+	return len(r.Added), len(r.Deleted), len(r.Modified)
+}
+
+// MODIFIED:
+// If a plan dictionary ID matches a state dictionary ID, and the name has changed, then it's been modified.
+//
+// REPLACED:
+// If a plan dictionary ID matches a state dictionary ID, and write_only has
+// changed, then it's both deleted and added (the API doesn't allow write_only
+// to be updated).
+//
+// ADDED:
+// If a plan dictionary ID doesn't exist in the state, then it's a new dictionary.
+//
+// DELETED:
+// If a state dictionary ID doesn't exist in the plan, then it's a deleted dictionary.
+//
+// RENAMED:
+// If an added dictionary has the same name and write_only as a deleted
+// dictionary, then only the map key has changed. This is a state-only move and
+// no API call is made.
+func changes(planDictionaries map[string]*models.Dictionary, stateDictionaries map[string]models.Dictionary) (changed bool, added, deleted, modified map[string]models.Dictionary) {
+	added = make(map[string]models.Dictionary)
+	modified = make(map[string]models.Dictionary)
+	deleted = make(map[string]models.Dictionary)
+
+	for planDictionaryID, planDictionaryData := range planDictionaries {
+		stateDictionaryData, foundDictionary := stateDictionaries[planDictionaryID]
+
+		switch {
+		case !foundDictionary:
+			added[planDictionaryID] = *planDictionaryData
+		case !planDictionaryData.WriteOnly.Equal(stateDictionaryData.WriteOnly):
+			deleted[planDictionaryID] = stateDictionaryData
+			added[planDictionaryID] = *planDictionaryData
+		case !planDictionaryData.Name.Equal(stateDictionaryData.Name):
+			// NOTE: We have to track the old state name for the API request.
+			// The Update API endpoint requires the old dictionary name be provided.
+			planDictionaryData.NamePast = types.StringValue(stateDictionaryData.Name.ValueString())
+
+			modified[planDictionaryID] = *planDictionaryData
+		}
+	}
+
+	for stateDictionaryID, stateDictionaryData := range stateDictionaries {
+		if _, ok := planDictionaries[stateDictionaryID]; !ok {
+			deleted[stateDictionaryID] = stateDictionaryData
+		}
+	}
+
+	for addedDictionaryID, addedDictionaryData := range added {
+		for deletedDictionaryID, deletedDictionaryData := range deleted {
+			if addedDictionaryID != deletedDictionaryID &&
+				addedDictionaryData.Name.Equal(deletedDictionaryData.Name) &&
+				addedDictionaryData.WriteOnly.Equal(deletedDictionaryData.WriteOnly) {
+				delete(added, addedDictionaryID)
+				delete(deleted, deletedDictionaryID)
+				// NOTE: The computed attributes are unknown for a new map key.
+				planDictionaries[addedDictionaryID].DictionaryID = deletedDictionaryData.DictionaryID
+				break
+			}
+		}
+	}
+
+	changed = len(added) > 0 || len(deleted) > 0 || len(modified) > 0
+
+	return changed, added, deleted, modified
+}
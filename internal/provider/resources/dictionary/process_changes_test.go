@@ -0,0 +1,57 @@
+package dictionary
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+func TestChangesRenamedKey(t *testing.T) {
+	stateDictionaries := map[string]models.Dictionary{
+		"old": {DictionaryID: types.StringValue("abc"), Name: types.StringValue("config"), WriteOnly: types.BoolValue(false)},
+	}
+	planDictionaries := map[string]*models.Dictionary{
+		"new": {DictionaryID: types.StringUnknown(), Name: types.StringValue("config"), WriteOnly: types.BoolValue(false)},
+	}
+
+	changed, added, deleted, modified := changes(planDictionaries, stateDictionaries)
+	if changed || len(added) > 0 || len(deleted) > 0 || len(modified) > 0 {
+		t.Errorf("expected a key-only rename to be a no-op, got added=%v deleted=%v modified=%v", added, deleted, modified)
+	}
+	if got := planDictionaries["new"].DictionaryID; !got.Equal(types.StringValue("abc")) {
+		t.Errorf("expected the dictionary ID to be moved to the new key, got %s", got)
+	}
+}
+
+func TestChangesRenamedDictionary(t *testing.T) {
+	stateDictionaries := map[string]models.Dictionary{
+		"config": {DictionaryID: types.StringValue("abc"), Name: types.StringValue("old"), WriteOnly: types.BoolValue(false)},
+	}
+	planDictionaries := map[string]*models.Dictionary{
+		"config": {DictionaryID: types.StringValue("abc"), Name: types.StringValue("new"), WriteOnly: types.BoolValue(false)},
+	}
+
+	changed, added, deleted, modified := changes(planDictionaries, stateDictionaries)
+	if !changed || len(added) > 0 || len(deleted) > 0 {
+		t.Errorf("expected a renamed dictionary to only be modified, got added=%v deleted=%v", added, deleted)
+	}
+	if got := modified["config"].NamePast.ValueString(); got != "old" {
+		t.Errorf("expected the prior name to be tracked, got %q", got)
+	}
+}
+
+func TestChangesWriteOnlyReplacement(t *testing.T) {
+	stateDictionaries := map[string]models.Dictionary{
+		"secrets": {DictionaryID: types.StringValue("abc"), Name: types.StringValue("secrets"), WriteOnly: types.BoolValue(false)},
+	}
+	planDictionaries := map[string]*models.Dictionary{
+		"secrets": {DictionaryID: types.StringUnknown(), Name: types.StringValue("secrets"), WriteOnly: types.BoolValue(true)},
+	}
+
+	changed, added, deleted, modified := changes(planDictionaries, stateDictionaries)
+	if !changed || len(added) != 1 || len(deleted) != 1 || len(modified) > 0 {
+		t.Errorf("expected a write_only change to delete and add the dictionary, got added=%v deleted=%v modified=%v", added, deleted, modified)
+	}
+}
@@ -0,0 +1,83 @@
+package dictionary
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Create is called when the provider must create a new resource.
+// Config and planned state values should be read from the CreateRequest.
+// New state values set on the CreateResponse.
+func (r *Resource) Create(
+	ctx context.Context,
+	req *resource.CreateRequest,
+	resp *resource.CreateResponse,
+	api helpers.API,
+	serviceData *helpers.Service,
+) error {
+	var dictionaries map[string]models.Dictionary
+	req.Plan.GetAttribute(ctx, path.Root("dictionaries"), &dictionaries)
+
+	// NOTE: Dictionaries are created concurrently (see helpers.MaxConcurrency).
+	// Each API call is given its own response so diagnostics can be safely
+	// appended once all API calls have completed.
+	dictionaryList := make([]models.Dictionary, 0, len(dictionaries))
+	for _, dictionaryData := range dictionaries {
+		dictionaryList = append(dictionaryList, dictionaryData)
+	}
+	dictionaryResps := make([]resource.CreateResponse, len(dictionaryList))
+
+	err := helpers.ForEach(len(dictionaryList), helpers.MaxConcurrency, func(i int) error {
+		return create(ctx, dictionaryList[i], api, serviceData, &dictionaryResps[i].Diagnostics)
+	})
+	for i := range dictionaryResps {
+		resp.Diagnostics.Append(dictionaryResps[i].Diagnostics...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return setComputed(ctx, &req.Plan, api, serviceData, &resp.Diagnostics)
+}
+
+// create is the common behaviour for creating this resource.
+func create(
+	ctx context.Context,
+	dictionaryData models.Dictionary,
+	api helpers.API,
+	service *helpers.Service,
+	diags *diag.Diagnostics,
+) error {
+	createErr := errors.New("failed to create dictionary resource")
+
+	clientReq := api.Client.DictionaryAPI.CreateDictionary(
+		api.ClientCtx,
+		service.ID,
+		service.Version,
+	)
+
+	clientReq.Name(dictionaryData.Name.ValueString())
+	clientReq.WriteOnly(dictionaryData.WriteOnly.ValueBool())
+
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly DictionaryAPI.CreateDictionary error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to create dictionary")
+		return createErr
+	}
+	defer httpResp.Body.Close()
+
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return createErr
+	}
+
+	return nil
+}
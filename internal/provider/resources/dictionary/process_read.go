@@ -0,0 +1,171 @@
+package dictionary
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Read is called when the provider must read resource values in order to update state.
+// Planned state values should be read from the ReadRequest.
+// New state values set on the ReadResponse.
+func (r *Resource) Read(
+	ctx context.Context,
+	req *resource.ReadRequest,
+	resp *resource.ReadResponse,
+	api helpers.API,
+	serviceData *helpers.Service,
+) error {
+	var dictionaries map[string]models.Dictionary
+	req.State.GetAttribute(ctx, path.Root("dictionaries"), &dictionaries)
+
+	remoteDictionaries, err := read(ctx, dictionaries, api, serviceData, &resp.Diagnostics)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: The `dictionaries` attribute is optional.
+	// So if it's unset and there are no remote dictionaries, it remains null.
+	if dictionaries == nil && len(remoteDictionaries) == 0 {
+		remoteDictionaries = nil
+	}
+
+	req.State.SetAttribute(ctx, path.Root("dictionaries"), &remoteDictionaries)
+
+	return nil
+}
+
+func read(
+	ctx context.Context,
+	stateDictionaries map[string]models.Dictionary,
+	api helpers.API,
+	service *helpers.Service,
+	diags *diag.Diagnostics,
+) (map[string]models.Dictionary, error) {
+	clientResp, err := list(ctx, api, service, diags)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: The state is rebuilt from the list of remote dictionaries.
+	// So any dictionary deleted outside of Terraform is removed from the state.
+	remoteDictionaries := make(map[string]models.Dictionary)
+
+	for _, remoteDictionary := range clientResp {
+		remoteDictionaryName := remoteDictionary.GetName()
+
+		// NOTE: It's highly unlikely a dictionary would have no name.
+		// But safer to just avoid accidentally setting a map key to an empty string.
+		if remoteDictionaryName == "" {
+			diags.AddError(helpers.ErrorAPI, "No dictionary name set in API response")
+			return nil, fmt.Errorf("no dictionary name set in API response")
+		}
+
+		// NOTE: The API has no concept of a map key for a dictionary.
+		// The key is arbitrarily chosen by the user and set in their config.
+		// If we can't match a remote dictionary with anything in the state, then
+		// we'll give the dictionary a uuid and treat it as a dictionary added
+		// out-of-band from Terraform.
+		remoteDictionaryID := uuid.New().String()
+		for stateDictionaryID, stateDictionaryData := range stateDictionaries {
+			if stateDictionaryData.Name.ValueString() == remoteDictionaryName {
+				remoteDictionaryID = stateDictionaryID
+			}
+		}
+
+		remoteDictionaries[remoteDictionaryID] = models.Dictionary{
+			DictionaryID: types.StringValue(remoteDictionary.GetID()),
+			Name:         types.StringValue(remoteDictionaryName),
+			WriteOnly:    types.BoolValue(remoteDictionary.GetWriteOnly()),
+		}
+	}
+
+	return remoteDictionaries, nil
+}
+
+// list returns the dictionaries of the service version.
+func list(
+	ctx context.Context,
+	api helpers.API,
+	service *helpers.Service,
+	diags *diag.Diagnostics,
+) ([]fastly.DictionaryResponse, error) {
+	// NOTE: The dictionaries are only decoded if they've changed since last read.
+	clientResp, httpResp, err := helpers.ReadConditional(api, func(clientCtx context.Context) ([]fastly.DictionaryResponse, *http.Response, error) {
+		return api.Client.DictionaryAPI.ListDictionaries(clientCtx, service.ID, service.Version).Execute()
+	})
+	if err != nil {
+		tflog.Trace(ctx, "Fastly DictionaryAPI.ListDictionaries error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to list dictionaries")
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return nil, err
+	}
+
+	return clientResp, nil
+}
+
+// setComputed sets any unknown computed attributes of the dictionaries in the
+// plan (i.e. those not yet known because the dictionary is new) from the API.
+func setComputed(
+	ctx context.Context,
+	plan *tfsdk.Plan,
+	api helpers.API,
+	service *helpers.Service,
+	diags *diag.Diagnostics,
+) error {
+	var dictionaries map[string]models.Dictionary
+	plan.GetAttribute(ctx, path.Root("dictionaries"), &dictionaries)
+
+	var unknown bool
+	for _, dictionaryData := range dictionaries {
+		if dictionaryData.DictionaryID.IsUnknown() {
+			unknown = true
+			break
+		}
+	}
+	if !unknown {
+		return nil
+	}
+
+	clientResp, err := list(ctx, api, service, diags)
+	if err != nil {
+		return err
+	}
+
+	for key, dictionaryData := range dictionaries {
+		if !dictionaryData.DictionaryID.IsUnknown() {
+			continue
+		}
+		// NOTE: If the dictionary isn't returned (which is unexpected) the ID is
+		// set to null, as Terraform doesn't allow unknown values once the apply
+		// has completed.
+		dictionaryData.DictionaryID = types.StringNull()
+		for _, remoteDictionary := range clientResp {
+			if remoteDictionary.GetName() == dictionaryData.Name.ValueString() {
+				dictionaryData.DictionaryID = types.StringValue(remoteDictionary.GetID())
+				break
+			}
+		}
+		dictionaries[key] = dictionaryData
+	}
+
+	plan.SetAttribute(ctx, path.Root("dictionaries"), &dictionaries)
+
+	return nil
+}
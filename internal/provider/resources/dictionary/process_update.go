@@ -0,0 +1,133 @@
+package dictionary
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Update is called to update the state of the resource.
+// Config, planned state, and prior state values should be read from the UpdateRequest.
+// New state values set on the UpdateResponse.
+func (r *Resource) Update(
+	ctx context.Context,
+	req *resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+	api helpers.API,
+	serviceData *helpers.Service,
+) error {
+	// IMPORTANT: We need to delete, then add, then update.
+	// Dictionary names must be unique within a service version, and a
+	// dictionary whose write_only attribute has changed is deleted and added
+	// with the same name.
+	//
+	// NOTE: Within each stage the API calls are made concurrently.
+	// But each stage must complete before the next stage begins.
+
+	if err := forEachDictionary(r.Deleted, resp, func(dictionaryData models.Dictionary, resp *resource.UpdateResponse) error {
+		return deleted(ctx, api, serviceData, dictionaryData, resp)
+	}); err != nil {
+		return err
+	}
+
+	if err := forEachDictionary(r.Added, resp, func(dictionaryData models.Dictionary, resp *resource.UpdateResponse) error {
+		return added(ctx, api, serviceData, dictionaryData, resp)
+	}); err != nil {
+		return err
+	}
+
+	if err := forEachDictionary(r.Modified, resp, func(dictionaryData models.Dictionary, resp *resource.UpdateResponse) error {
+		return modified(ctx, api, serviceData, dictionaryData, resp)
+	}); err != nil {
+		return err
+	}
+
+	r.Added = nil
+	r.Deleted = nil
+	r.Modified = nil
+	r.Changed = false
+
+	return setComputed(ctx, &req.Plan, api, serviceData, &resp.Diagnostics)
+}
+
+// forEachDictionary calls fn concurrently for each dictionary.
+//
+// Each call is given its own response so diagnostics can be safely appended
+// to resp (once all calls have completed).
+func forEachDictionary(
+	dictionaries map[string]models.Dictionary,
+	resp *resource.UpdateResponse,
+	fn func(dictionaryData models.Dictionary, resp *resource.UpdateResponse) error,
+) error {
+	dictionaryList := make([]models.Dictionary, 0, len(dictionaries))
+	for _, dictionaryData := range dictionaries {
+		dictionaryList = append(dictionaryList, dictionaryData)
+	}
+	dictionaryResps := make([]resource.UpdateResponse, len(dictionaryList))
+
+	err := helpers.ForEach(len(dictionaryList), helpers.MaxConcurrency, func(i int) error {
+		return fn(dictionaryList[i], &dictionaryResps[i])
+	})
+	for i := range dictionaryResps {
+		resp.Diagnostics.Append(dictionaryResps[i].Diagnostics...)
+	}
+
+	return err
+}
+
+func deleted(
+	ctx context.Context,
+	api helpers.API,
+	serviceData *helpers.Service,
+	dictionaryData models.Dictionary,
+	resp *resource.UpdateResponse,
+) error {
+	clientReq := api.Client.DictionaryAPI.DeleteDictionary(api.ClientCtx, serviceData.ID, serviceData.Version, dictionaryData.Name.ValueString())
+
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly DictionaryAPI.DeleteDictionary error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to delete dictionary")
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	return helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics)
+}
+
+func added(
+	ctx context.Context,
+	api helpers.API,
+	serviceData *helpers.Service,
+	dictionaryData models.Dictionary,
+	resp *resource.UpdateResponse,
+) error {
+	return create(ctx, dictionaryData, api, serviceData, &resp.Diagnostics)
+}
+
+// modified renames a dictionary (the name is the only attribute that can be
+// updated), which keeps its ID and so its items.
+func modified(
+	ctx context.Context,
+	api helpers.API,
+	serviceData *helpers.Service,
+	dictionaryData models.Dictionary,
+	resp *resource.UpdateResponse,
+) error {
+	clientReq := api.Client.DictionaryAPI.UpdateDictionary(api.ClientCtx, serviceData.ID, serviceData.Version, dictionaryData.NamePast.ValueString())
+	clientReq.Name(dictionaryData.Name.ValueString())
+
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly DictionaryAPI.UpdateDictionary error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to update dictionary")
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	return helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics)
+}
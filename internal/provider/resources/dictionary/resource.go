@@ -0,0 +1,43 @@
+package dictionary
+
+import (
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/interfaces"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/registry"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/schemas"
+)
+
+// NOTE: Edge dictionaries are only supported by VCL services.
+func init() {
+	registry.Register(registry.NestedResource{
+		Attribute:    "dictionaries",
+		New:          NewResource,
+		Schema:       schemas.Dictionaries,
+		ServiceTypes: []helpers.ServiceType{helpers.ServiceTypeVCL},
+	})
+}
+
+// NewResource returns a new resource entity.
+func NewResource() interfaces.Resource {
+	return &Resource{}
+}
+
+// Resource represents a Fastly entity.
+type Resource struct {
+	// Added represents any new resources.
+	Added map[string]models.Dictionary
+	// Deleted represents any deleted resources.
+	Deleted map[string]models.Dictionary
+	// Modified represents any modified resources.
+	Modified map[string]models.Dictionary
+	// Changed indicates if the resource has changes.
+	Changed bool
+}
+
+// Attribute returns the name of the top-level service attribute.
+func (r *Resource) Attribute() string {
+	return "dictionaries"
+}
+
+// NOTE: Schema defined in ../../schemas/dictionaries.go
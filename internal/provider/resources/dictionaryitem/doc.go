@@ -0,0 +1,2 @@
+// Package dictionaryitem implements a single dictionary item resource.
+package dictionaryitem
@@ -0,0 +1,59 @@
+package dictionaryitem
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Create is called when the provider must create a new resource.
+// Config and planned state values should be read from the CreateRequest.
+// New state values set on the CreateResponse.
+//
+// NOTE: The API rejects the item if the key already exists. This avoids
+// Terraform silently taking ownership of a key that is managed elsewhere.
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	api, finishSpan := r.newAPI(ctx, "Create")
+	defer finishSpan(&resp.Diagnostics)
+
+	var plan *models.DictionaryItem
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after plan population")
+		return
+	}
+
+	clientReq := api.Client.DictionaryItemAPI.CreateDictionaryItem(api.ClientCtx, plan.ServiceID.ValueString(), plan.DictionaryID.ValueString())
+	clientReq.ItemKey(plan.Key.ValueString())
+	clientReq.ItemValue(plan.Value.ValueString())
+
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly DictionaryItemAPI.CreateDictionaryItem error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to create dictionary item")
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", plan.ServiceID.ValueString(), plan.DictionaryID.ValueString(), plan.Key.ValueString()))
+
+	// Save the planned changes into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Create", map[string]any{"state": helpers.LogState(plan)})
+}
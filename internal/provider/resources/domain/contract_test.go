@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/mockapi"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// TestContractWildcardDomain validates a wildcard domain name is correctly
+// escaped in the API request path when the domain is updated and deleted.
+func TestContractWildcardDomain(t *testing.T) {
+	server, api, serviceID := mockapi.NewService(t)
+
+	service := &helpers.Service{ID: serviceID, Version: 1}
+	domainData := models.Domain{
+		Comment: types.StringValue("wildcard"),
+		Name:    types.StringValue("*.example.com"),
+	}
+
+	var resp resource.UpdateResponse
+	if err := added(context.Background(), api, service, domainData, &resp); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, resp.Diagnostics)
+	}
+
+	domainData.Comment = types.StringValue("updated")
+	if err := modified(context.Background(), api, service, domainData, &resp); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, resp.Diagnostics)
+	}
+	if got := server.Service(service.ID).Versions[0].Domains[0].Comment; got != "updated" {
+		t.Errorf("expected wildcard domain comment to be updated, got %q", got)
+	}
+
+	if err := deleted(context.Background(), api, service, domainData, &resp); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, resp.Diagnostics)
+	}
+	if n := len(server.Service(service.ID).Versions[0].Domains); n != 0 {
+		t.Errorf("expected wildcard domain to be deleted, got %d domains", n)
+	}
+}
+
+// TestContractDomainMove validates a domain creation that conflicts with
+// another service is retried once the domain has been released.
+func TestContractDomainMove(t *testing.T) {
+	server, api, serviceID := mockapi.NewService(t)
+
+	service := &helpers.Service{ID: serviceID, Version: 1}
+	domainData := models.Domain{
+		Comment: types.StringNull(),
+		Name:    types.StringValue("moved.example.com"),
+	}
+
+	// The domain is still associated with another service, which is releasing
+	// it in the same apply.
+	helpers.ExpectDomainRelease(domainData.Name.ValueString())
+	server.FailNext(http.MethodPost, "/service/"+service.ID+"/version/1/domain", http.StatusConflict)
+
+	var diags diag.Diagnostics
+	done := make(chan error)
+	go func() {
+		done <- create(context.Background(), domainData, api, service, &diags)
+	}()
+
+	for {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("unexpected error: %s (%v)", err, diags)
+			}
+			if n := len(server.Service(service.ID).Versions[0].Domains); n != 1 {
+				t.Errorf("expected domain to be created, got %d domains", n)
+			}
+			return
+		case <-time.After(10 * time.Millisecond):
+			// The other service deletes the domain.
+			helpers.ReleaseDomain(domainData.Name.ValueString())
+		}
+	}
+}
+
+// TestContractDomainConflict validates a domain creation that conflicts with
+// a service which isn't releasing the domain fails without waiting.
+func TestContractDomainConflict(t *testing.T) {
+	server, api, serviceID := mockapi.NewService(t)
+
+	service := &helpers.Service{ID: serviceID, Version: 1}
+	domainData := models.Domain{
+		Comment: types.StringNull(),
+		Name:    types.StringValue("unmanaged.example.com"),
+	}
+
+	// The domain belongs to a service that isn't managed by this apply.
+	server.FailNext(http.MethodPost, "/service/"+service.ID+"/version/1/domain", http.StatusConflict)
+
+	start := time.Now()
+	var diags diag.Diagnostics
+	if err := create(context.Background(), domainData, api, service, &diags); err == nil {
+		t.Fatal("expected the conflict to be returned")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected the conflict to be returned immediately, took %s", elapsed)
+	}
+	if n := len(server.Service(service.ID).Versions[0].Domains); n != 0 {
+		t.Errorf("expected no domain to be created, got %d domains", n)
+	}
+}
@@ -45,6 +45,11 @@ func (r *Resource) HasChanges() bool {
 	return r.Changed
 }
 
+// ChangeCounts returns the number of added, deleted and modified domains.
+func (r *Resource) ChangeCounts() (added, deleted, modified int) {
+	return len(r.Added), len(r.Deleted), len(r.Modified)
+}
+
 // MODIFIED:
 // If a plan domain ID matches a state domain ID, and a nested attribute has changed, then it's been modified.
 //
@@ -53,6 +58,11 @@ func (r *Resource) HasChanges() bool {
 //
 // DELETED:
 // If a state domain ID doesn't exist in the plan, then it's a deleted domain.
+//
+// RENAMED:
+// If an added domain has the same name as a deleted domain, then only the map
+// key has changed. This is a state-only move and no API call is made (unless
+// the comment has also changed, in which case the domain is modified).
 func changes(planDomains map[string]*models.Domain, stateDomains map[string]models.Domain) (changed bool, added, deleted, modified map[string]models.Domain) {
 	added = make(map[string]models.Domain)
 	modified = make(map[string]models.Domain)
@@ -66,7 +76,6 @@ func changes(planDomains map[string]*models.Domain, stateDomains map[string]mode
 				foundDomain = true
 				if !planDomainData.Comment.Equal(stateDomainData.Comment) {
 					modified[planDomainID] = *planDomainData
-					changed = true
 				}
 				if !planDomainData.Name.Equal(stateDomainData.Name) {
 					// NOTE: We have to track the old state name for the API request.
@@ -74,7 +83,6 @@ func changes(planDomains map[string]*models.Domain, stateDomains map[string]mode
 					planDomainData.NamePast = types.StringValue(stateDomainData.Name.ValueString())
 
 					modified[planDomainID] = *planDomainData
-					changed = true
 				}
 				break
 			}
@@ -82,7 +90,6 @@ func changes(planDomains map[string]*models.Domain, stateDomains map[string]mode
 
 		if !foundDomain {
 			added[planDomainID] = *planDomainData
-			changed = true
 		}
 	}
 
@@ -97,9 +104,27 @@ func changes(planDomains map[string]*models.Domain, stateDomains map[string]mode
 
 		if !foundDomain {
 			deleted[stateDomainID] = stateDomainData
-			changed = true
 		}
 	}
 
+	for addedDomainID, addedDomainData := range added {
+		for deletedDomainID, deletedDomainData := range deleted {
+			if addedDomainData.Name.Equal(deletedDomainData.Name) {
+				delete(added, addedDomainID)
+				delete(deleted, deletedDomainID)
+				// NOTE: The computed attributes are unknown for a new map key.
+				planDomains[addedDomainID].CreatedAt = deletedDomainData.CreatedAt
+				planDomains[addedDomainID].UpdatedAt = deletedDomainData.UpdatedAt
+				addedDomainData = *planDomains[addedDomainID]
+				if !addedDomainData.Comment.Equal(deletedDomainData.Comment) {
+					modified[addedDomainID] = addedDomainData
+				}
+				break
+			}
+		}
+	}
+
+	changed = len(added) > 0 || len(deleted) > 0 || len(modified) > 0
+
 	return changed, added, deleted, modified
 }
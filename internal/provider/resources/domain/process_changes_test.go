@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+func TestChangesRenamedKey(t *testing.T) {
+	createdAt := types.StringValue("2024-01-01T00:00:00Z")
+	stateDomains := map[string]models.Domain{
+		"old": {Name: types.StringValue("example.com"), Comment: types.StringNull(), CreatedAt: createdAt, UpdatedAt: createdAt},
+	}
+	planDomains := map[string]*models.Domain{
+		"new": {Name: types.StringValue("example.com"), Comment: types.StringNull(), CreatedAt: types.StringUnknown(), UpdatedAt: types.StringUnknown()},
+	}
+
+	changed, added, deleted, modified := changes(planDomains, stateDomains)
+	if changed || len(added) > 0 || len(deleted) > 0 || len(modified) > 0 {
+		t.Errorf("expected a key-only rename to be a no-op, got added=%v deleted=%v modified=%v", added, deleted, modified)
+	}
+	if !planDomains["new"].CreatedAt.Equal(createdAt) || !planDomains["new"].UpdatedAt.Equal(createdAt) {
+		t.Errorf("expected computed attributes to be moved to the new key, got %v", planDomains["new"])
+	}
+}
+
+func TestChangesRenamedKeyWithComment(t *testing.T) {
+	stateDomains := map[string]models.Domain{
+		"old": {Name: types.StringValue("example.com"), Comment: types.StringNull()},
+	}
+	planDomains := map[string]*models.Domain{
+		"new": {Name: types.StringValue("example.com"), Comment: types.StringValue("updated")},
+	}
+
+	changed, added, deleted, modified := changes(planDomains, stateDomains)
+	if !changed || len(added) > 0 || len(deleted) > 0 {
+		t.Errorf("expected a rename with a new comment to only modify the domain, got added=%v deleted=%v", added, deleted)
+	}
+	if _, ok := modified["new"]; !ok {
+		t.Errorf("expected domain to be modified, got %v", modified)
+	}
+}
+
+func TestChangesReplacedDomain(t *testing.T) {
+	stateDomains := map[string]models.Domain{
+		"old": {Name: types.StringValue("a.example.com"), Comment: types.StringNull()},
+	}
+	planDomains := map[string]*models.Domain{
+		"new": {Name: types.StringValue("b.example.com"), Comment: types.StringNull()},
+	}
+
+	changed, added, deleted, _ := changes(planDomains, stateDomains)
+	if !changed || len(added) != 1 || len(deleted) != 1 {
+		t.Errorf("expected domain to be deleted and added, got added=%v deleted=%v", added, deleted)
+	}
+}
@@ -3,9 +3,9 @@ package domain
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -27,50 +27,83 @@ func (r *Resource) Create(
 	var domains map[string]models.Domain
 	req.Plan.GetAttribute(ctx, path.Root("domains"), &domains)
 
+	// NOTE: Domains are created concurrently (see helpers.MaxConcurrency).
+	// Each API call is given its own response so diagnostics can be safely
+	// appended once all API calls have completed.
+	domainList := make([]models.Domain, 0, len(domains))
 	for _, domainData := range domains {
-		if err := create(ctx, domainData, api, serviceData, resp); err != nil {
-			return err
-		}
+		domainList = append(domainList, domainData)
+	}
+	domainResps := make([]resource.CreateResponse, len(domainList))
+
+	err := helpers.ForEach(len(domainList), helpers.MaxConcurrency, func(i int) error {
+		return create(ctx, domainList[i], api, serviceData, &domainResps[i].Diagnostics)
+	})
+	for i := range domainResps {
+		resp.Diagnostics.Append(domainResps[i].Diagnostics...)
+	}
+	if err != nil {
+		return err
 	}
 
 	req.Plan.SetAttribute(ctx, path.Root("domains"), &domains)
 
-	return nil
+	return setComputed(ctx, &req.Plan, api, serviceData, &resp.Diagnostics)
 }
 
 // create is the common behaviour for creating this resource.
+//
+// NOTE: If the domain is associated with another service, the API returns a
+// conflict. If the other service is releasing the domain in the same apply
+// (i.e. the domain is being moved between services), then we wait for it to be
+// released and retry, for up to helpers.DomainMoveTimeout. Otherwise (e.g. the
+// domain belongs to a service that isn't managed by this configuration) the
+// conflict is returned immediately.
 func create(
 	ctx context.Context,
 	domainData models.Domain,
 	api helpers.API,
 	service *helpers.Service,
-	resp *resource.CreateResponse,
+	diags *diag.Diagnostics,
 ) error {
 	createErr := errors.New("failed to create domain resource")
+	domainName := domainData.Name.ValueString()
 
-	clientReq := api.Client.DomainAPI.CreateDomain(
-		api.ClientCtx,
-		service.ID,
-		service.Version,
+	var (
+		httpResp *http.Response
+		err      error
 	)
+	// NOTE: The poll never fails, so if the wait times out (or is cancelled)
+	// then err is the last conflict.
+	_ = helpers.DomainReleaseWaiter(domainName).Wait(api.ClientCtx, func(attempt int) (bool, error) {
+		clientReq := api.Client.DomainAPI.CreateDomain(
+			api.ClientCtx,
+			service.ID,
+			service.Version,
+		)
 
-	clientReq.Name(domainData.Name.ValueString())
+		clientReq.Name(domainName)
 
-	if !domainData.Comment.IsNull() {
-		clientReq.Comment(domainData.Comment.ValueString())
-	}
+		if !domainData.Comment.IsNull() {
+			clientReq.Comment(domainData.Comment.ValueString())
+		}
 
-	_, httpResp, err := clientReq.Execute()
+		_, httpResp, err = clientReq.Execute()
+		if err != nil && httpResp != nil && httpResp.StatusCode == http.StatusConflict && helpers.DomainReleaseExpected(domainName) {
+			httpResp.Body.Close()
+			tflog.Debug(ctx, "Domain is associated with another service, waiting for it to be released", map[string]any{"attempt": attempt, "domain": domainName})
+			return false, nil
+		}
+		return true, nil
+	})
 	if err != nil {
-		tflog.Trace(ctx, "Fastly DomainAPI.CreateDomain error", map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to create domain, got error: %s", err))
+		tflog.Trace(ctx, "Fastly DomainAPI.CreateDomain error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to create domain")
 		return createErr
 	}
 	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode != http.StatusOK {
-		tflog.Trace(ctx, helpers.ErrorAPI, map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPI, fmt.Sprintf("Unsuccessful status code: %s", httpResp.Status))
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
 		return createErr
 	}
 
@@ -2,12 +2,14 @@ package domain
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
+	"github.com/fastly/fastly-go/fastly"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -33,6 +35,12 @@ func (r *Resource) Read(
 		return err
 	}
 
+	// NOTE: The `domains` attribute is optional (when `activate` is `false`).
+	// So if it's unset and there are no remote domains, it remains null.
+	if domains == nil && len(remoteDomains) == 0 {
+		remoteDomains = nil
+	}
+
 	req.State.SetAttribute(ctx, path.Root("domains"), &remoteDomains)
 
 	return nil
@@ -45,32 +53,32 @@ func read(
 	service *helpers.Service,
 	resp *resource.ReadResponse,
 ) (map[string]models.Domain, error) {
-	clientReq := api.Client.DomainAPI.ListDomains(
-		api.ClientCtx,
-		service.ID,
-		service.Version,
-	)
-
-	clientResp, httpResp, err := clientReq.Execute()
+	// NOTE: The domains are only decoded if they've changed since last read.
+	clientResp, httpResp, err := helpers.ReadConditional(api, func(clientCtx context.Context) ([]fastly.DomainResponse, *http.Response, error) {
+		return api.Client.DomainAPI.ListDomains(clientCtx, service.ID, service.Version).Execute()
+	})
 	if err != nil {
-		tflog.Trace(ctx, "Fastly DomainAPI.ListDomains error", map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to list domains, got error: %s", err))
+		tflog.Trace(ctx, "Fastly DomainAPI.ListDomains error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to list domains")
 		return nil, err
 	}
 	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode != http.StatusOK {
-		tflog.Trace(ctx, helpers.ErrorAPI, map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPI, fmt.Sprintf("Unsuccessful status code: %s", httpResp.Status))
+	if err := helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics); err != nil {
 		return nil, err
 	}
 
+	// NOTE: The state is rebuilt from the list of remote domains.
+	// So any domain deleted outside of Terraform is removed from the state.
 	remoteDomains := make(map[string]models.Domain)
 
 	for _, remoteDomain := range clientResp {
 		remoteDomainName := remoteDomain.GetName()
 		remoteDomainData := models.Domain{
-			Name: types.StringValue(remoteDomainName),
+			CreatedAt: helpers.Timestamp(remoteDomain.CreatedAt),
+			IsApex:    types.BoolValue(helpers.IsApexDomain(remoteDomainName)),
+			Name:      types.StringValue(remoteDomainName),
+			UpdatedAt: helpers.Timestamp(remoteDomain.UpdatedAt),
 		}
 
 		// NOTE: The API has no concept of an ID for a domain.
@@ -78,11 +86,13 @@ func read(
 		// The ID must be unique and is used as a key for accessing a domain.
 		var (
 			found          bool
+			priorComment   = types.StringNull()
 			remoteDomainID string
 		)
 
 		for stateDomainID, stateDomainData := range stateDomains {
 			if stateDomainData.Name.ValueString() == remoteDomainName {
+				priorComment = stateDomainData.Comment
 				remoteDomainID = stateDomainID
 				found = true
 			}
@@ -95,68 +105,16 @@ func read(
 			remoteDomainID = uuid.New().String()
 		}
 
-		// NOTE: We call the Ok variant of the API so we can check if value was set.
-		// WARNING: The code doesn't work as you might expect because of the Fastly API.
-		//
-		// The Fastly API always returns an empty string (and not null). This means
-		// we get a conflict with the state file, as it stores the value as <null>.
-		//
-		// To avoid a "plan was not empty" test error, because Terraform sees the
-		// value was <null> in the state but now is an empty string, we need to
-		// check the state to see if the comment was originally <null> and to force
-		// setting it back to null rather than using the empty string from the API.
-		//
-		// Ideally the Fastly API would return null or omit the field so the API
-		// client could handle whether the value returned was null. So I've left the
-		// conditional logic here but have added to the else statement additional
-		// logic for working around the issue with the Fastly API response.
-		if v, ok := remoteDomain.GetCommentOk(); ok {
-			// Set comment to whatever is returned by the API (could be an empty
-			// string and that might be because the user set that explicitly or it
-			// could be because it was never set and the API is just returning an
-			// empty string as a default value).
-			remoteDomainData.Comment = types.StringValue(*v)
-
-			// We need to check if the user config has set the comment.
-			// If not, then we'll again set the value to null to avoid a plan diff.
-			// See the above WARNING for the details.
-			for _, stateDomainData := range stateDomains {
-				if stateDomainData.Name.ValueString() == remoteDomainName {
-					if stateDomainData.Comment.IsNull() {
-						remoteDomainData.Comment = types.StringNull()
-					}
-				}
-			}
-
-			// Domains is a required attribute, so if there is a length of zero, then
-			// we know that the Read method was called after an import (as an import
-			// only sets the Service ID). This means we can't check the prior state to
-			// see if the user had configured a value for the comment.
-			//
-			// WARNING: The domain comment logic for import scenarios is fragile.
-			//
-			// The problem we have is that a user can set an empty string as a comment
-			// value to the Fastly API. This means when importing a service, as we
-			// have no prior state to compare with, we can't tell if the value
-			// returned by the Fastly API is an empty string because the comment was
-			// never set by the user (and the API defaults to returning an empty
-			// string) or if it's an empty string because the user's service actually
-			// had it set explicitly to be an empty string. In reality, it's very
-			// unlikely that a user is going to configure an empty string for the
-			// domain comment (they'll more likely just omit the attribute). So we'll
-			// presume that if we're in an 'import' scenario and the comment value is
-			// an empty string, that we should set the comment attribute to null.
-			if len(stateDomains) == 0 && *v == "" {
-				remoteDomainData.Comment = types.StringNull()
-			}
-		} else {
-			remoteDomainData.Comment = types.StringNull()
-		}
+		// NOTE: The Fastly API returns an empty string for an unset comment.
+		// If the domain isn't in the prior state (e.g. an import), then there is
+		// no prior comment and so an empty string is treated as unset.
+		comment, _ := remoteDomain.GetCommentOk()
+		remoteDomainData.Comment = helpers.NullableString(comment, priorComment)
 
 		// NOTE: It's highly unlikely a domain would have no name.
 		// But safer to just avoid accidentally setting a map key to an empty string.
 		if remoteDomainName == "" {
-			tflog.Trace(ctx, helpers.ErrorAPI, map[string]any{"http_resp": httpResp})
+			tflog.Trace(ctx, helpers.ErrorAPI, map[string]any{"http_resp": helpers.LogResponse(httpResp)})
 			resp.Diagnostics.AddError(helpers.ErrorAPI, "No domain name set in API response")
 			return nil, err
 		}
@@ -166,3 +124,65 @@ func read(
 
 	return remoteDomains, nil
 }
+
+// setComputed sets any unknown computed attributes of the domains in the plan
+// (i.e. those not yet known because the domain is new) from the API.
+func setComputed(
+	ctx context.Context,
+	plan *tfsdk.Plan,
+	api helpers.API,
+	service *helpers.Service,
+	diags *diag.Diagnostics,
+) error {
+	var domains map[string]models.Domain
+	plan.GetAttribute(ctx, path.Root("domains"), &domains)
+
+	var unknown bool
+	for _, domainData := range domains {
+		if domainData.CreatedAt.IsUnknown() || domainData.UpdatedAt.IsUnknown() {
+			unknown = true
+			break
+		}
+	}
+	if !unknown {
+		return nil
+	}
+
+	clientReq := api.Client.DomainAPI.ListDomains(api.ClientCtx, service.ID, service.Version)
+	clientResp, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly DomainAPI.ListDomains error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to list domains")
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return err
+	}
+
+	for domainID, domainData := range domains {
+		// NOTE: If the domain isn't returned (which is unexpected) the computed
+		// values are set to null, as Terraform doesn't allow unknown values once
+		// the apply has completed.
+		createdAt, updatedAt := types.StringNull(), types.StringNull()
+		for _, remoteDomain := range clientResp {
+			if remoteDomain.GetName() == domainData.Name.ValueString() {
+				createdAt = helpers.Timestamp(remoteDomain.CreatedAt)
+				updatedAt = helpers.Timestamp(remoteDomain.UpdatedAt)
+				break
+			}
+		}
+		if domainData.CreatedAt.IsUnknown() {
+			domainData.CreatedAt = createdAt
+		}
+		if domainData.UpdatedAt.IsUnknown() {
+			domainData.UpdatedAt = updatedAt
+		}
+		domains[domainID] = domainData
+	}
+
+	plan.SetAttribute(ctx, path.Root("domains"), &domains)
+
+	return nil
+}
@@ -2,8 +2,6 @@ package domain
 
 import (
 	"context"
-	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -17,7 +15,7 @@ import (
 // New state values set on the UpdateResponse.
 func (r *Resource) Update(
 	ctx context.Context,
-	_ *resource.UpdateRequest,
+	req *resource.UpdateRequest,
 	resp *resource.UpdateResponse,
 	api helpers.API,
 	serviceData *helpers.Service,
@@ -35,22 +33,25 @@ func (r *Resource) Update(
 	// We should make them a single type (as the API is one endpoint).
 	// Then we can expose a `dynamic` boolean attribute to control the type.
 
-	for _, domainData := range r.Deleted {
-		if err := deleted(ctx, api, serviceData, domainData, resp); err != nil {
-			return err
-		}
+	// NOTE: Within each stage the API calls are made concurrently.
+	// But each stage must complete before the next stage begins.
+
+	if err := forEachDomain(r.Deleted, resp, func(domainData models.Domain, resp *resource.UpdateResponse) error {
+		return deleted(ctx, api, serviceData, domainData, resp)
+	}); err != nil {
+		return err
 	}
 
-	for _, domainData := range r.Added {
-		if err := added(ctx, api, serviceData, domainData, resp); err != nil {
-			return err
-		}
+	if err := forEachDomain(r.Added, resp, func(domainData models.Domain, resp *resource.UpdateResponse) error {
+		return added(ctx, api, serviceData, domainData, resp)
+	}); err != nil {
+		return err
 	}
 
-	for _, domainData := range r.Modified {
-		if err := modified(ctx, api, serviceData, domainData, resp); err != nil {
-			return err
-		}
+	if err := forEachDomain(r.Modified, resp, func(domainData models.Domain, resp *resource.UpdateResponse) error {
+		return modified(ctx, api, serviceData, domainData, resp)
+	}); err != nil {
+		return err
 	}
 
 	r.Added = nil
@@ -58,7 +59,32 @@ func (r *Resource) Update(
 	r.Modified = nil
 	r.Changed = false
 
-	return nil
+	return setComputed(ctx, &req.Plan, api, serviceData, &resp.Diagnostics)
+}
+
+// forEachDomain calls fn concurrently for each domain.
+//
+// Each call is given its own response so diagnostics can be safely appended
+// to resp (once all calls have completed).
+func forEachDomain(
+	domains map[string]models.Domain,
+	resp *resource.UpdateResponse,
+	fn func(domainData models.Domain, resp *resource.UpdateResponse) error,
+) error {
+	domainList := make([]models.Domain, 0, len(domains))
+	for _, domainData := range domains {
+		domainList = append(domainList, domainData)
+	}
+	domainResps := make([]resource.UpdateResponse, len(domainList))
+
+	err := helpers.ForEach(len(domainList), helpers.MaxConcurrency, func(i int) error {
+		return fn(domainList[i], &domainResps[i])
+	})
+	for i := range domainResps {
+		resp.Diagnostics.Append(domainResps[i].Diagnostics...)
+	}
+
+	return err
 }
 
 func deleted(
@@ -72,15 +98,13 @@ func deleted(
 
 	_, httpResp, err := clientReq.Execute()
 	if err != nil {
-		tflog.Trace(ctx, "Fastly DomainAPI.DeleteDomain error", map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to delete domain, got error: %s", err))
+		tflog.Trace(ctx, "Fastly DomainAPI.DeleteDomain error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to delete domain")
 		return err
 	}
 	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode != http.StatusOK {
-		tflog.Trace(ctx, helpers.ErrorAPI, map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPI, fmt.Sprintf("Unsuccessful status code: %s", httpResp.Status))
+	if err := helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics); err != nil {
 		return err
 	}
 
@@ -94,28 +118,7 @@ func added(
 	domainData models.Domain,
 	resp *resource.UpdateResponse,
 ) error {
-	clientReq := api.Client.DomainAPI.CreateDomain(api.ClientCtx, serviceData.ID, serviceData.Version)
-	clientReq.Name(domainData.Name.ValueString())
-
-	if !domainData.Comment.IsNull() {
-		clientReq.Comment(domainData.Comment.ValueString())
-	}
-
-	_, httpResp, err := clientReq.Execute()
-	if err != nil {
-		tflog.Trace(ctx, "Fastly DomainAPI.CreateDomain error", map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to create domain, got error: %s", err))
-		return err
-	}
-	defer httpResp.Body.Close()
-
-	if httpResp.StatusCode != http.StatusOK {
-		tflog.Trace(ctx, helpers.ErrorAPI, map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPI, fmt.Sprintf("Unsuccessful status code: %s", httpResp.Status))
-		return err
-	}
-
-	return nil
+	return create(ctx, domainData, api, serviceData, &resp.Diagnostics)
 }
 
 func modified(
@@ -132,22 +135,20 @@ func modified(
 	}
 
 	clientReq := api.Client.DomainAPI.UpdateDomain(api.ClientCtx, serviceData.ID, serviceData.Version, domainNameParam)
-	if !domainData.Comment.IsNull() {
-		clientReq.Comment(domainData.Comment.ValueString())
-	}
+	// NOTE: A null comment is sent as an empty string so a removed comment is
+	// cleared (the API represents an unset comment as an empty string).
+	clientReq.Comment(domainData.Comment.ValueString())
 	clientReq.Name(domainData.Name.ValueString())
 
 	_, httpResp, err := clientReq.Execute()
 	if err != nil {
-		tflog.Trace(ctx, "Fastly DomainAPI.UpdateDomain error", map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to update domain, got error: %s", err))
+		tflog.Trace(ctx, "Fastly DomainAPI.UpdateDomain error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to update domain")
 		return err
 	}
 	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode != http.StatusOK {
-		tflog.Trace(ctx, helpers.ErrorAPI, map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPI, fmt.Sprintf("Unsuccessful status code: %s", httpResp.Status))
+	if err := helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics); err != nil {
 		return err
 	}
 
@@ -3,8 +3,19 @@ package domain
 import (
 	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/interfaces"
 	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/registry"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/schemas"
 )
 
+// NOTE: Domains are supported by both VCL and Compute services.
+func init() {
+	registry.Register(registry.NestedResource{
+		Attribute: "domains",
+		New:       NewResource,
+		Schema:    schemas.Domains,
+	})
+}
+
 // NewResource returns a new resource entity.
 func NewResource() interfaces.Resource {
 	return &Resource{}
@@ -22,4 +33,9 @@ type Resource struct {
 	Changed bool
 }
 
-// NOTE: Schema defined in ../../schemas/service.go
+// Attribute returns the name of the top-level service attribute.
+func (r *Resource) Attribute() string {
+	return "domains"
+}
+
+// NOTE: Schema defined in ../../schemas/domains.go
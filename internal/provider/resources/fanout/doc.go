@@ -0,0 +1,2 @@
+// Package fanout implements a Fanout product enablement resource.
+package fanout
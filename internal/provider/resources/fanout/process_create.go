@@ -0,0 +1,67 @@
+package fanout
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Create is called when the provider must create a new resource.
+// Config and planned state values should be read from the CreateRequest.
+// New state values set on the CreateResponse.
+//
+// Creating the resource enables Fanout for the service.
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	api, finishSpan := r.newAPI(ctx, "Create")
+	defer finishSpan(&resp.Diagnostics)
+
+	var plan *models.Fanout
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after plan population")
+		return
+	}
+
+	serviceID := plan.ServiceID.ValueString()
+
+	// Fanout is only supported by Compute services.
+	// So we check the service type to avoid an opaque API error.
+	clientReq := api.Client.ServiceAPI.GetServiceDetail(api.ClientCtx, serviceID)
+	clientResp, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly ServiceAPI.GetServiceDetail error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to retrieve service details")
+		return
+	}
+	defer httpResp.Body.Close()
+
+	serviceType := clientResp.GetType()
+	wasmServiceType := helpers.ServiceTypeWasm.String()
+	if serviceType != wasmServiceType {
+		tflog.Trace(ctx, "Fastly service type error", map[string]any{"http_resp": helpers.LogResponse(httpResp), "type": serviceType})
+		resp.Diagnostics.AddError(helpers.ErrorUser, fmt.Sprintf("Fanout requires a Compute service (type %s), got: %s", wasmServiceType, serviceType))
+		return
+	}
+
+	if err := helpers.EnableProduct(ctx, api, helpers.ProductFanout, serviceID, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	plan.ID = plan.ServiceID
+
+	// Save the planned changes into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Create", map[string]any{"state": helpers.LogState(plan)})
+}
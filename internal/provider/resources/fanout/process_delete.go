@@ -0,0 +1,38 @@
+package fanout
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Delete is called when the provider must delete the resource.
+// Config values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically call
+// DeleteResponse.State.RemoveResource().
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	api, finishSpan := r.newAPI(ctx, "Delete")
+	defer finishSpan(&resp.Diagnostics)
+
+	var state *models.Fanout
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after state population")
+		return
+	}
+
+	if err := helpers.DisableProduct(ctx, api, helpers.ProductFanout, state.ServiceID.ValueString(), &resp.Diagnostics); err != nil {
+		return
+	}
+
+	tflog.Debug(ctx, "Delete", map[string]any{"state": helpers.LogState(state)})
+}
@@ -0,0 +1,47 @@
+package fanout
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Read is called when the provider must read resource values in order to update state.
+// Planned state values should be read from the ReadRequest.
+// New state values set on the ReadResponse.
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	api, finishSpan := r.newAPI(ctx, "Read")
+	defer finishSpan(&resp.Diagnostics)
+
+	var state *models.Fanout
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after state population")
+		return
+	}
+
+	enabled, err := helpers.ProductEnabled(ctx, api, helpers.ProductFanout, state.ServiceID.ValueString(), &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	// Fanout was disabled outside of Terraform, so the next plan will enable it.
+	if !enabled {
+		tflog.Warn(ctx, "Fanout not enabled, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save the updated state data back into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Read", map[string]any{"state": helpers.LogState(state)})
+}
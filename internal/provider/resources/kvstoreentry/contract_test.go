@@ -0,0 +1,79 @@
+package kvstoreentry
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/mockapi"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// TestContractPutValue validates an entry is only created if the key doesn't
+// exist, and only updated if its generation hasn't changed.
+func TestContractPutValue(t *testing.T) {
+	server := mockapi.NewServer()
+	t.Cleanup(server.Close)
+
+	api := helpers.API{
+		Client:    server.Client(),
+		ClientCtx: context.Background(),
+	}
+
+	data := &models.KVStoreEntry{
+		Key:        types.StringValue("path/to/key"),
+		Metadata:   types.StringValue("owner=terraform"),
+		Source:     types.StringNull(),
+		StoreID:    types.StringValue("store"),
+		TimeToLive: types.Int64Null(),
+		Value:      types.StringValue(""),
+	}
+
+	var diags diag.Diagnostics
+	if err := putValue(context.Background(), api, data, writeOptions{add: true}, &diags); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+	entry := server.KVEntry("store", "path/to/key")
+	if entry == nil || entry.Value != "" || entry.Metadata != "owner=terraform" {
+		t.Fatalf("want an empty value with metadata, got: %+v", entry)
+	}
+	if data.Generation.ValueString() != "1" {
+		t.Errorf("want generation 1, got: %s", data.Generation)
+	}
+
+	if err := putValue(context.Background(), api, data, writeOptions{add: true}, &diags); err == nil || !diags.HasError() {
+		t.Error("want an error creating an existing key")
+	}
+
+	// A large value is streamed from the source file.
+	source := filepath.Join(t.TempDir(), "value")
+	value := strings.Repeat("x", 1<<20)
+	if err := os.WriteFile(source, []byte(value), 0o600); err != nil {
+		t.Fatalf("failed to write source file: %s", err)
+	}
+	data.Source = types.StringValue(source)
+	data.Value = types.StringNull()
+
+	diags = nil
+	if err := putValue(context.Background(), api, data, writeOptions{generation: "1"}, &diags); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+	if entry := server.KVEntry("store", "path/to/key"); entry.Value != value {
+		t.Errorf("want the source file content (%d bytes), got %d bytes", len(value), len(entry.Value))
+	}
+
+	// Another writer changes the entry.
+	server.SetKVEntry("store", "path/to/key", "external")
+	if err := putValue(context.Background(), api, data, writeOptions{generation: data.Generation.ValueString()}, &diags); err == nil || !diags.HasError() {
+		t.Error("want an error updating an entry changed by another writer")
+	}
+	if entry := server.KVEntry("store", "path/to/key"); entry.Value != "external" {
+		t.Errorf("want the other writer's value to be kept, got: %q", entry.Value)
+	}
+}
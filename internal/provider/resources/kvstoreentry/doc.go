@@ -0,0 +1,2 @@
+// Package kvstoreentry implements a single KV store entry resource.
+package kvstoreentry
@@ -0,0 +1,149 @@
+package kvstoreentry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Create is called when the provider must create a new resource.
+// Config and planned state values should be read from the CreateRequest.
+// New state values set on the CreateResponse.
+//
+// NOTE: The API rejects the entry if the key already exists. This avoids
+// Terraform silently taking ownership of a key that is managed elsewhere.
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	api, finishSpan := r.newAPI(ctx, "Create")
+	defer finishSpan(&resp.Diagnostics)
+
+	var plan *models.KVStoreEntry
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after plan population")
+		return
+	}
+
+	if err := putValue(ctx, api, plan, writeOptions{add: true}, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.StoreID.ValueString(), plan.Key.ValueString()))
+
+	// Save the planned changes into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Create", map[string]any{"state": helpers.LogState(plan)})
+}
+
+// writeOptions are the conditions for writing an entry.
+type writeOptions struct {
+	// add only writes the entry if the key doesn't exist.
+	add bool
+	// generation only writes the entry if it has the given generation.
+	generation string
+}
+
+// bodyPlaceholder is the request body given to the API client, which is then
+// replaced by helpers.BodyMiddleware.
+//
+// NOTE: The API client only accepts a (non-empty) string body, which would
+// require the whole value to be held in memory.
+const bodyPlaceholder = "-"
+
+// putValue writes the entry value (from `value` or the `source` file) and sets
+// the new generation of the entry.
+func putValue(ctx context.Context, api helpers.API, data *models.KVStoreEntry, opts writeOptions, diags *diag.Diagnostics) error {
+	open, size, err := valueReader(data)
+	if err != nil {
+		diags.AddError(helpers.ErrorUser, fmt.Sprintf("Unable to read the entry value, got error: %s", err))
+		return err
+	}
+	if size > maxValueSize {
+		err := fmt.Errorf("the value is %d bytes, which exceeds the limit of %d bytes", size, maxValueSize)
+		diags.AddError(helpers.ErrorUser, fmt.Sprintf("Unable to write the entry, got error: %s", err))
+		return err
+	}
+
+	middleware := []helpers.Middleware{helpers.BodyMiddleware(open, size)}
+	// NOTE: The API client's IfGenerationMatch only accepts an int32, which is
+	// too small for a generation, so the header is set by middleware instead.
+	if opts.generation != "" {
+		middleware = append(middleware, helpers.HeaderMiddleware("If-Generation-Match", func() string { return opts.generation }))
+	}
+	api = api.WithMiddleware(middleware...)
+
+	clientReq := api.Client.KvStoreItemAPI.SetValueForKey(api.ClientCtx, data.StoreID.ValueString(), data.Key.ValueString())
+	clientReq.Body(bodyPlaceholder)
+	if opts.add {
+		clientReq.Add(true)
+	}
+	if !data.Metadata.IsNull() {
+		clientReq.Metadata(data.Metadata.ValueString())
+	}
+	if !data.TimeToLive.IsNull() {
+		clientReq.TimeToLiveSec(int32(data.TimeToLive.ValueInt64()))
+	}
+
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly KvStoreItemAPI.SetValueForKey error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		if httpResp != nil && httpResp.StatusCode == http.StatusPreconditionFailed {
+			if opts.add {
+				diags.AddError(helpers.ErrorAPI, fmt.Sprintf("The key '%s' already exists in the KV store. Import the entry to manage it with Terraform.", data.Key.ValueString()))
+			} else {
+				diags.AddError(helpers.ErrorAPI, fmt.Sprintf("The entry '%s' was changed by another writer since the state was last refreshed (generation %s), so it wasn't overwritten. Run `terraform plan` again to review the change.", data.Key.ValueString(), opts.generation))
+			}
+			return err
+		}
+		helpers.APIError(httpResp, err, diags, "Unable to write the KV store entry")
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	data.Generation = generation(httpResp)
+	return nil
+}
+
+// valueReader returns a function opening the entry value, and its size.
+func valueReader(data *models.KVStoreEntry) (func() (io.ReadCloser, error), int64, error) {
+	if data.Source.IsNull() {
+		value := data.Value.ValueString()
+		return func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(value)), nil
+		}, int64(len(value)), nil
+	}
+
+	source := data.Source.ValueString()
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, 0, err
+	}
+	return func() (io.ReadCloser, error) {
+		return os.Open(source) // #nosec G304
+	}, info.Size(), nil
+}
+
+// generation returns the generation of the entry from the API response.
+func generation(httpResp *http.Response) types.String {
+	if v := httpResp.Header.Get("Generation"); v != "" {
+		return types.StringValue(v)
+	}
+	return types.StringNull()
+}
@@ -0,0 +1,132 @@
+package logging_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/mockapi"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/logging"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/loggingkafka"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/loggingkinesis"
+)
+
+// endpointContract describes the expected API calls of an endpoint type.
+type endpointContract[T comparable] struct {
+	// endpoint is the endpoint type being validated.
+	endpoint logging.Endpoint[T]
+	// endpointType is the endpoint type in the API path (e.g. kafka).
+	endpointType string
+	// endpointData is the endpoint to create.
+	endpointData T
+	// wantForm are the form fields the endpoint must be created with.
+	wantForm map[string]string
+	// omitted are the unset attributes that mustn't be sent.
+	omitted []string
+	// secrets are the attribute values that must be redacted from the logs.
+	secrets []string
+}
+
+// run validates the shape of the endpoint creation, the endpoint is read back
+// into the state, the endpoint is deleted by name, and the secrets aren't
+// logged.
+func (c endpointContract[T]) run(t *testing.T) {
+	server, api, serviceID := mockapi.NewService(t)
+	service := &helpers.Service{ID: serviceID, Version: 1}
+	name := c.endpoint.Name(c.endpointData)
+
+	var diags diag.Diagnostics
+	if err := c.endpoint.Create(context.Background(), api, service, c.endpointData, &diags); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+
+	requests := server.Requests()
+	form := requests[len(requests)-1].Form
+	for k, want := range c.wantForm {
+		if got := form.Get(k); got != want {
+			t.Errorf("want %s %q, got: %q", k, want, got)
+		}
+	}
+	for _, k := range c.omitted {
+		if form.Has(k) {
+			t.Errorf("expected unset attribute %s to be omitted, got %v", k, form)
+		}
+	}
+
+	prior := func(string) T { return c.endpointData }
+	remote, err := c.endpoint.List(context.Background(), api, service, prior, &diags)
+	if err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+	if len(remote) != 1 || remote[0] != c.endpointData {
+		t.Errorf("expected the endpoint to be read back unchanged, got %v", remote)
+	}
+
+	if err := c.endpoint.Delete(context.Background(), api, service, name, &diags); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+	if endpoints := server.Service(service.ID).Versions[0].Logging[c.endpointType]; len(endpoints) != 0 {
+		t.Errorf("expected the endpoint to be deleted, got %v", endpoints)
+	}
+
+	got := helpers.LogState(c.endpointData)
+	for _, secret := range c.secrets {
+		if got == "" || strings.Contains(got, secret) {
+			t.Errorf("expected %q to be redacted, got %s", secret, got)
+		}
+	}
+}
+
+// TestContractEndpoints validates every logging endpoint type against the
+// mock API.
+func TestContractEndpoints(t *testing.T) {
+	format := types.StringValue(`%h %l %u %t "%r" %>s %b`)
+
+	for name, run := range map[string]func(*testing.T){
+		"kafka": endpointContract[models.LoggingKafka]{
+			endpoint:     loggingkafka.Endpoint,
+			endpointType: "kafka",
+			endpointData: models.LoggingKafka{
+				AuthMethod:      types.StringValue("scram-sha-256"),
+				Brokers:         types.StringValue("broker.example.com:9093"),
+				Format:          format,
+				FormatVersion:   types.Int64Value(2),
+				Name:            types.StringValue("kafka"),
+				ParseLogKeyvals: types.BoolValue(false),
+				Password:        types.StringValue("secret"),
+				RequestMaxBytes: types.Int64Value(0),
+				RequiredAcks:    types.Int64Value(1),
+				Topic:           types.StringValue("logs"),
+				UseTLS:          types.BoolValue(true),
+				User:            types.StringValue("fastly"),
+			},
+			// NOTE: use_tls is sent as an integer.
+			wantForm: map[string]string{"auth_method": "scram-sha-256", "format_version": "2", "required_acks": "1", "use_tls": "1", "user": "fastly"},
+			omitted:  []string{"compression_codec", "tls_client_key"},
+			secrets:  []string{"secret"},
+		}.run,
+		"kinesis": endpointContract[models.LoggingKinesis]{
+			endpoint:     loggingkinesis.Endpoint,
+			endpointType: "kinesis",
+			endpointData: models.LoggingKinesis{
+				AccessKey:     types.StringValue("AKIAEXAMPLE"),
+				Format:        format,
+				FormatVersion: types.Int64Value(2),
+				Name:          types.StringValue("kinesis"),
+				Region:        types.StringValue("eu-west-1"),
+				SecretKey:     types.StringValue("secret"),
+				Topic:         types.StringValue("logs"),
+			},
+			wantForm: map[string]string{"access_key": "AKIAEXAMPLE", "region": "eu-west-1", "topic": "logs"},
+			omitted:  []string{"iam_role", "placement"},
+			secrets:  []string{"AKIAEXAMPLE", "secret"},
+		}.run,
+	} {
+		t.Run(name, run)
+	}
+}
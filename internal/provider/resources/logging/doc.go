@@ -0,0 +1,3 @@
+// Package logging implements the behaviour shared by the logging endpoint
+// resources (e.g. Kafka), so each endpoint only implements its API calls.
+package logging
@@ -0,0 +1,110 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// InspectChanges checks for configuration changes and persists to data model.
+func (r *Resource[T]) InspectChanges(
+	ctx context.Context,
+	req *resource.UpdateRequest,
+	_ *resource.UpdateResponse,
+	_ helpers.API,
+	_ *helpers.Service,
+) (bool, error) {
+	var planEndpoints map[string]T
+	var stateEndpoints map[string]T
+
+	req.Plan.GetAttribute(ctx, path.Root(r.attribute), &planEndpoints)
+	req.State.GetAttribute(ctx, path.Root(r.attribute), &stateEndpoints)
+
+	r.Changed, r.Added, r.Deleted, r.Modified, r.namesPast = changes(r.endpoint, planEndpoints, stateEndpoints)
+
+	// NOTE: The endpoints contain credentials, so they're logged redacted.
+	tflog.Debug(ctx, "Logging endpoints", map[string]any{
+		"attribute": r.attribute,
+		"added":     logEndpoints(r.Added),
+		"deleted":   logEndpoints(r.Deleted),
+		"modified":  logEndpoints(r.Modified),
+		"changed":   r.Changed,
+	})
+
+	return r.Changed, nil
+}
+
+// HasChanges indicates if the nested resource contains configuration changes.
+func (r *Resource[T]) HasChanges() bool {
+	return r.Changed
+}
+
+// ChangeCounts returns the number of added, deleted and modified endpoints.
+func (r *Resource[T]) ChangeCounts() (added, deleted, modified int) {
+	return len(r.Added), len(r.Deleted), len(r.Modified)
+}
+
+// MODIFIED:
+// If a plan endpoint ID matches a state endpoint ID, and a nested attribute has changed, then it's been modified.
+//
+// ADDED:
+// If a plan endpoint ID doesn't exist in the state, then it's a new endpoint.
+//
+// DELETED:
+// If a state endpoint ID doesn't exist in the plan, then it's a deleted endpoint.
+//
+// RENAMED:
+// If an added endpoint has the same configuration as a deleted endpoint, then
+// only the map key has changed. This is a state-only move and no API call is made.
+func changes[T comparable](endpoint Endpoint[T], planEndpoints, stateEndpoints map[string]T) (changed bool, added, deleted, modified map[string]T, namesPast map[string]string) {
+	added = make(map[string]T)
+	modified = make(map[string]T)
+	deleted = make(map[string]T)
+	namesPast = make(map[string]string)
+
+	for planEndpointID, planEndpointData := range planEndpoints {
+		stateEndpointData, found := stateEndpoints[planEndpointID]
+		switch {
+		case !found:
+			added[planEndpointID] = planEndpointData
+		case planEndpointData != stateEndpointData:
+			// NOTE: We have to track the old state name for the API request.
+			// The old endpoint is deleted by name before the new one is created.
+			namesPast[planEndpointID] = endpoint.Name(stateEndpointData)
+			modified[planEndpointID] = planEndpointData
+		}
+	}
+
+	for stateEndpointID, stateEndpointData := range stateEndpoints {
+		if _, ok := planEndpoints[stateEndpointID]; !ok {
+			deleted[stateEndpointID] = stateEndpointData
+		}
+	}
+
+	for addedEndpointID, addedEndpointData := range added {
+		for deletedEndpointID, deletedEndpointData := range deleted {
+			if addedEndpointData == deletedEndpointData {
+				delete(added, addedEndpointID)
+				delete(deleted, deletedEndpointID)
+				break
+			}
+		}
+	}
+
+	changed = len(added) > 0 || len(deleted) > 0 || len(modified) > 0
+
+	return changed, added, deleted, modified, namesPast
+}
+
+// logEndpoints returns the endpoints with their credentials redacted.
+func logEndpoints[T any](endpoints map[string]T) map[string]string {
+	logged := make(map[string]string, len(endpoints))
+	for endpointID, endpointData := range endpoints {
+		logged[endpointID] = helpers.LogState(endpointData)
+	}
+	return logged
+}
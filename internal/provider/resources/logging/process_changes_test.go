@@ -0,0 +1,85 @@
+package logging
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// testModel is the data model of a test logging endpoint.
+type testModel struct {
+	Name   types.String `tfsdk:"name"`
+	Secret types.String `tfsdk:"secret" sensitive:"true"`
+}
+
+// testEndpoint records the API calls for a test logging endpoint.
+type testEndpoint struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (e *testEndpoint) Name(endpointData testModel) string {
+	return endpointData.Name.ValueString()
+}
+
+func (e *testEndpoint) Create(_ context.Context, _ helpers.API, _ *helpers.Service, endpointData testModel, _ *diag.Diagnostics) error {
+	e.record("create " + endpointData.Name.ValueString())
+	return nil
+}
+
+func (e *testEndpoint) Delete(_ context.Context, _ helpers.API, _ *helpers.Service, name string, _ *diag.Diagnostics) error {
+	e.record("delete " + name)
+	return nil
+}
+
+func (e *testEndpoint) List(_ context.Context, _ helpers.API, _ *helpers.Service, _ func(string) testModel, _ *diag.Diagnostics) ([]testModel, error) {
+	return nil, nil
+}
+
+func (e *testEndpoint) record(call string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.calls = append(e.calls, call)
+}
+
+func testEndpointData(name string) testModel {
+	return testModel{Name: types.StringValue(name)}
+}
+
+func TestChangesRenamedKey(t *testing.T) {
+	stateEndpoints := map[string]testModel{"old": testEndpointData("example")}
+	planEndpoints := map[string]testModel{"new": testEndpointData("example")}
+
+	changed, added, deleted, modified, _ := changes[testModel](&testEndpoint{}, planEndpoints, stateEndpoints)
+	if changed || len(added) > 0 || len(deleted) > 0 || len(modified) > 0 {
+		t.Errorf("expected a key-only rename to be a no-op, got added=%v deleted=%v modified=%v", added, deleted, modified)
+	}
+}
+
+func TestChangesModifiedEndpoint(t *testing.T) {
+	stateEndpoints := map[string]testModel{"example": testEndpointData("old")}
+	planEndpoints := map[string]testModel{"example": testEndpointData("new")}
+
+	changed, added, deleted, modified, namesPast := changes[testModel](&testEndpoint{}, planEndpoints, stateEndpoints)
+	if !changed || len(added) > 0 || len(deleted) > 0 || len(modified) != 1 {
+		t.Errorf("expected the endpoint to only be modified, got added=%v deleted=%v modified=%v", added, deleted, modified)
+	}
+	if got := namesPast["example"]; got != "old" {
+		t.Errorf("expected the prior name to be tracked, got %q", got)
+	}
+}
+
+func TestLogEndpointsRedacted(t *testing.T) {
+	endpointData := testEndpointData("example")
+	endpointData.Secret = types.StringValue("secret")
+
+	if got := logEndpoints(map[string]testModel{"example": endpointData})["example"]; got == "" || strings.Contains(got, "secret") {
+		t.Errorf("expected the secret to be redacted, got %s", got)
+	}
+}
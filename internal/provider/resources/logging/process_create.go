@@ -0,0 +1,30 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// Create is called when the provider must create a new resource.
+// Config and planned state values should be read from the CreateRequest.
+// New state values set on the CreateResponse.
+func (r *Resource[T]) Create(
+	ctx context.Context,
+	req *resource.CreateRequest,
+	resp *resource.CreateResponse,
+	api helpers.API,
+	serviceData *helpers.Service,
+) error {
+	var endpoints map[string]T
+	req.Plan.GetAttribute(ctx, path.Root(r.attribute), &endpoints)
+
+	// NOTE: Endpoints are created concurrently (see helpers.MaxConcurrency).
+	return forEach(values(endpoints), &resp.Diagnostics, func(endpointData T, diags *diag.Diagnostics) error {
+		return r.endpoint.Create(ctx, api, serviceData, endpointData, diags)
+	})
+}
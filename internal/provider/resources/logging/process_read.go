@@ -0,0 +1,74 @@
+package logging
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// Read is called when the provider must read resource values in order to update state.
+// Planned state values should be read from the ReadRequest.
+// New state values set on the ReadResponse.
+func (r *Resource[T]) Read(
+	ctx context.Context,
+	req *resource.ReadRequest,
+	resp *resource.ReadResponse,
+	api helpers.API,
+	serviceData *helpers.Service,
+) error {
+	var endpoints map[string]T
+	req.State.GetAttribute(ctx, path.Root(r.attribute), &endpoints)
+
+	// NOTE: The API has no concept of a map key for an endpoint.
+	// The key is arbitrarily chosen by the user and set in their config.
+	stateEndpointIDs := make(map[string]string, len(endpoints))
+	for stateEndpointID, stateEndpointData := range endpoints {
+		stateEndpointIDs[r.endpoint.Name(stateEndpointData)] = stateEndpointID
+	}
+	prior := func(name string) T {
+		return endpoints[stateEndpointIDs[name]]
+	}
+
+	remoteList, err := r.endpoint.List(ctx, api, serviceData, prior, &resp.Diagnostics)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: The state is rebuilt from the list of remote endpoints.
+	// So any endpoint deleted outside of Terraform is removed from the state.
+	remoteEndpoints := make(map[string]T, len(remoteList))
+	for _, remoteEndpoint := range remoteList {
+		remoteEndpointName := r.endpoint.Name(remoteEndpoint)
+
+		// NOTE: It's highly unlikely an endpoint would have no name.
+		// But safer to just avoid accidentally setting a map key to an empty string.
+		if remoteEndpointName == "" {
+			resp.Diagnostics.AddError(helpers.ErrorAPI, "No logging endpoint name set in API response")
+			return errors.New("no logging endpoint name set in API response")
+		}
+
+		// If we can't match a remote endpoint with anything in the state, then
+		// we'll give the endpoint a uuid and treat it as an endpoint added
+		// out-of-band from Terraform.
+		remoteEndpointID, ok := stateEndpointIDs[remoteEndpointName]
+		if !ok {
+			remoteEndpointID = uuid.New().String()
+		}
+		remoteEndpoints[remoteEndpointID] = remoteEndpoint
+	}
+
+	// NOTE: The attribute is optional.
+	// So if it's unset and there are no remote endpoints, it remains null.
+	if endpoints == nil && len(remoteEndpoints) == 0 {
+		remoteEndpoints = nil
+	}
+
+	req.State.SetAttribute(ctx, path.Root(r.attribute), &remoteEndpoints)
+
+	return nil
+}
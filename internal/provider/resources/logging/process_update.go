@@ -0,0 +1,85 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// Update is called to update the state of the resource.
+// Config, planned state, and prior state values should be read from the UpdateRequest.
+// New state values set on the UpdateResponse.
+func (r *Resource[T]) Update(
+	ctx context.Context,
+	_ *resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+	api helpers.API,
+	serviceData *helpers.Service,
+) error {
+	// IMPORTANT: We need to delete, then add.
+	// Endpoint names must be unique within a service version.
+	//
+	// NOTE: The API client doesn't support updating most endpoint types, so a
+	// modified endpoint is deleted (by its prior name) and created again.
+	// This is safe as the changes are made to a draft service version.
+	//
+	// NOTE: Within each stage the API calls are made concurrently.
+	// But each stage must complete before the next stage begins.
+
+	deleteNames := make([]string, 0, len(r.Deleted)+len(r.namesPast))
+	for _, endpointData := range r.Deleted {
+		deleteNames = append(deleteNames, r.endpoint.Name(endpointData))
+	}
+	for _, namePast := range r.namesPast {
+		deleteNames = append(deleteNames, namePast)
+	}
+	if err := forEach(deleteNames, &resp.Diagnostics, func(name string, diags *diag.Diagnostics) error {
+		return r.endpoint.Delete(ctx, api, serviceData, name, diags)
+	}); err != nil {
+		return err
+	}
+
+	createEndpoints := append(values(r.Added), values(r.Modified)...)
+	if err := forEach(createEndpoints, &resp.Diagnostics, func(endpointData T, diags *diag.Diagnostics) error {
+		return r.endpoint.Create(ctx, api, serviceData, endpointData, diags)
+	}); err != nil {
+		return err
+	}
+
+	r.Added = nil
+	r.Deleted = nil
+	r.Modified = nil
+	r.Changed = false
+	r.namesPast = nil
+
+	return nil
+}
+
+// forEach calls fn concurrently for each item (see helpers.MaxConcurrency).
+//
+// Each call is given its own diagnostics so they can be safely appended to
+// diags (once all calls have completed).
+func forEach[E any](items []E, diags *diag.Diagnostics, fn func(item E, diags *diag.Diagnostics) error) error {
+	itemDiags := make([]diag.Diagnostics, len(items))
+
+	err := helpers.ForEach(len(items), helpers.MaxConcurrency, func(i int) error {
+		return fn(items[i], &itemDiags[i])
+	})
+	for i := range itemDiags {
+		diags.Append(itemDiags[i]...)
+	}
+
+	return err
+}
+
+// values returns the values of the map (in no particular order).
+func values[T any](m map[string]T) []T {
+	list := make([]T, 0, len(m))
+	for _, v := range m {
+		list = append(list, v)
+	}
+	return list
+}
@@ -0,0 +1,51 @@
+package logging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// TestUpdateStages validates every endpoint is deleted (a modified endpoint by
+// its prior name) before any endpoint is created, as endpoint names must be
+// unique within a service version.
+func TestUpdateStages(t *testing.T) {
+	endpoint := &testEndpoint{}
+	r := NewResource[testModel]("logging_test", endpoint).(*Resource[testModel])
+
+	stateEndpoints := map[string]testModel{
+		"deleted":  testEndpointData("deleted"),
+		"modified": testEndpointData("old"),
+	}
+	planEndpoints := map[string]testModel{
+		// NOTE: The added endpoint reuses the name of the deleted endpoint, but
+		// with a different configuration (so it's not a key-only rename).
+		"added":    {Name: types.StringValue("deleted"), Secret: types.StringValue("secret")},
+		"modified": testEndpointData("new"),
+	}
+	r.Changed, r.Added, r.Deleted, r.Modified, r.namesPast = changes[testModel](endpoint, planEndpoints, stateEndpoints)
+
+	var resp resource.UpdateResponse
+	if err := r.Update(context.Background(), nil, &resp, helpers.API{}, &helpers.Service{}); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, resp.Diagnostics)
+	}
+
+	if len(endpoint.calls) != 4 {
+		t.Fatalf("expected 4 API calls, got %v", endpoint.calls)
+	}
+	deletes := map[string]bool{endpoint.calls[0]: true, endpoint.calls[1]: true}
+	creates := map[string]bool{endpoint.calls[2]: true, endpoint.calls[3]: true}
+	if !deletes["delete deleted"] || !deletes["delete old"] {
+		t.Errorf("expected the deleted and modified endpoints to be deleted first, got %v", endpoint.calls)
+	}
+	if !creates["create deleted"] || !creates["create new"] {
+		t.Errorf("expected the added and modified endpoints to be created last, got %v", endpoint.calls)
+	}
+	if r.HasChanges() {
+		t.Error("expected the changes to be reset")
+	}
+}
@@ -0,0 +1,71 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/interfaces"
+)
+
+// Endpoint is implemented by each logging endpoint type, where T is the data
+// model of a single endpoint.
+//
+// NOTE: T must be comparable, as an endpoint is modified if any attribute has
+// changed (the framework attribute values are comparable structs).
+type Endpoint[T comparable] interface {
+	// Name returns the name of the endpoint, which is unique within the service
+	// version (for the endpoint type).
+	Name(endpoint T) string
+	// Create creates the endpoint.
+	Create(ctx context.Context, api helpers.API, service *helpers.Service, endpoint T, diags *diag.Diagnostics) error
+	// Delete deletes the named endpoint.
+	Delete(ctx context.Context, api helpers.API, service *helpers.Service, name string, diags *diag.Diagnostics) error
+	// List returns the endpoints of the service version.
+	// The prior state of a named endpoint (or a zero value) is provided to
+	// distinguish an empty string returned by the API from a null value.
+	List(ctx context.Context, api helpers.API, service *helpers.Service, prior func(name string) T, diags *diag.Diagnostics) ([]T, error)
+}
+
+// NewResource returns a new resource entity for the endpoint type, which
+// manages the given top-level service attribute.
+func NewResource[T comparable](attribute string, endpoint Endpoint[T]) interfaces.Resource {
+	return &Resource[T]{
+		attribute: attribute,
+		endpoint:  endpoint,
+	}
+}
+
+// Resource represents a Fastly entity.
+type Resource[T comparable] struct {
+	// Added represents any new resources.
+	Added map[string]T
+	// Deleted represents any deleted resources.
+	Deleted map[string]T
+	// Modified represents any modified resources.
+	Modified map[string]T
+	// Changed indicates if the resource has changes.
+	Changed bool
+
+	attribute string
+	endpoint  Endpoint[T]
+	// namesPast are the prior names of the modified resources, used to delete
+	// the prior endpoint (keyed by the resource ID).
+	namesPast map[string]string
+}
+
+// Attribute returns the name of the top-level service attribute.
+func (r *Resource[T]) Attribute() string {
+	return r.attribute
+}
+
+// Optional calls set with the value of v, unless v is null (or unknown).
+// It's used to only send the optional attributes that are configured.
+// e.g. `logging.Optional(plan.Password, clientReq.Password)`
+func Optional[R any](v types.String, set func(string) R) {
+	if !v.IsNull() && !v.IsUnknown() {
+		set(v.ValueString())
+	}
+}
@@ -0,0 +1,2 @@
+// Package loggingkafka implements a Kafka logging endpoint resource.
+package loggingkafka
@@ -0,0 +1,71 @@
+package loggingkafka
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/logging"
+)
+
+// Create creates the endpoint.
+func (kafka) Create(
+	ctx context.Context,
+	api helpers.API,
+	service *helpers.Service,
+	endpointData models.LoggingKafka,
+	diags *diag.Diagnostics,
+) error {
+	createErr := errors.New("failed to create Kafka logging endpoint resource")
+
+	clientReq := api.Client.LoggingKafkaAPI.CreateLogKafka(
+		api.ClientCtx,
+		service.ID,
+		service.Version,
+	)
+
+	clientReq.Name(endpointData.Name.ValueString())
+	clientReq.Brokers(endpointData.Brokers.ValueString())
+	clientReq.Topic(endpointData.Topic.ValueString())
+	clientReq.Format(endpointData.Format.ValueString())
+	clientReq.FormatVersion(int32(endpointData.FormatVersion.ValueInt64()))
+	clientReq.ParseLogKeyvals(endpointData.ParseLogKeyvals.ValueBool())
+	clientReq.RequestMaxBytes(int32(endpointData.RequestMaxBytes.ValueInt64()))
+	clientReq.RequiredAcks(int32(endpointData.RequiredAcks.ValueInt64()))
+
+	useTLS := fastly.LOGGINGUSETLS_no_tls
+	if endpointData.UseTLS.ValueBool() {
+		useTLS = fastly.LOGGINGUSETLS_use_tls
+	}
+	clientReq.UseTLS(useTLS)
+
+	logging.Optional(endpointData.AuthMethod, clientReq.AuthMethod)
+	logging.Optional(endpointData.CompressionCodec, clientReq.CompressionCodec)
+	logging.Optional(endpointData.Password, clientReq.Password)
+	logging.Optional(endpointData.Placement, clientReq.Placement)
+	logging.Optional(endpointData.ResponseCondition, clientReq.ResponseCondition)
+	logging.Optional(endpointData.TLSCACert, clientReq.TLSCaCert)
+	logging.Optional(endpointData.TLSClientCert, clientReq.TLSClientCert)
+	logging.Optional(endpointData.TLSClientKey, clientReq.TLSClientKey)
+	logging.Optional(endpointData.TLSHostname, clientReq.TLSHostname)
+	logging.Optional(endpointData.User, clientReq.User)
+
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly LoggingKafkaAPI.CreateLogKafka error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to create Kafka logging endpoint")
+		return createErr
+	}
+	defer httpResp.Body.Close()
+
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return createErr
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package loggingkafka
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// Delete deletes the named endpoint.
+//
+// NOTE: The API client doesn't support updating a Kafka endpoint, so a
+// modified endpoint is also deleted and created again (see logging.Resource).
+func (kafka) Delete(
+	ctx context.Context,
+	api helpers.API,
+	service *helpers.Service,
+	name string,
+	diags *diag.Diagnostics,
+) error {
+	clientReq := api.Client.LoggingKafkaAPI.DeleteLogKafka(api.ClientCtx, service.ID, service.Version, name)
+
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly LoggingKafkaAPI.DeleteLogKafka error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to delete Kafka logging endpoint")
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	return helpers.CheckStatus(ctx, httpResp, diags)
+}
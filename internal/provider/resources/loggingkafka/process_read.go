@@ -0,0 +1,78 @@
+package loggingkafka
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// List returns the endpoints of the service version.
+func (kafka) List(
+	ctx context.Context,
+	api helpers.API,
+	service *helpers.Service,
+	prior func(name string) models.LoggingKafka,
+	diags *diag.Diagnostics,
+) ([]models.LoggingKafka, error) {
+	// NOTE: The endpoints are only decoded if they've changed since last read.
+	clientResp, httpResp, err := helpers.ReadConditional(api, func(clientCtx context.Context) ([]fastly.LoggingKafkaResponse, *http.Response, error) {
+		return api.Client.LoggingKafkaAPI.ListLogKafka(clientCtx, service.ID, service.Version).Execute()
+	})
+	if err != nil {
+		tflog.Trace(ctx, "Fastly LoggingKafkaAPI.ListLogKafka error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to list Kafka logging endpoints")
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return nil, err
+	}
+
+	remoteEndpoints := make([]models.LoggingKafka, 0, len(clientResp))
+	for _, remoteEndpoint := range clientResp {
+		remoteEndpoints = append(remoteEndpoints, endpoint(remoteEndpoint, prior(remoteEndpoint.GetName())))
+	}
+
+	return remoteEndpoints, nil
+}
+
+// endpoint returns the data model of a remote endpoint.
+//
+// NOTE: The API returns an empty string for an unset optional attribute, so
+// the prior state value (if any) is used to distinguish it from a null value
+// (see helpers.NullableString). An endpoint that isn't in the prior state has
+// a zero value prior, whose attributes are null.
+func endpoint(remote fastly.LoggingKafkaResponse, prior models.LoggingKafka) models.LoggingKafka {
+	formatVersion, _ := strconv.ParseInt(remote.GetFormatVersion(), 10, 64)
+
+	return models.LoggingKafka{
+		AuthMethod:        helpers.NullableString(remote.AuthMethod, prior.AuthMethod),
+		Brokers:           types.StringValue(remote.GetBrokers()),
+		CompressionCodec:  helpers.NullableString(remote.CompressionCodec.Get(), prior.CompressionCodec),
+		Format:            types.StringValue(remote.GetFormat()),
+		FormatVersion:     types.Int64Value(formatVersion),
+		Name:              types.StringValue(remote.GetName()),
+		ParseLogKeyvals:   types.BoolValue(remote.GetParseLogKeyvals()),
+		Password:          helpers.NullableString(remote.Password, prior.Password),
+		Placement:         helpers.NullableString(remote.Placement.Get(), prior.Placement),
+		RequestMaxBytes:   types.Int64Value(int64(remote.GetRequestMaxBytes())),
+		RequiredAcks:      types.Int64Value(int64(remote.GetRequiredAcks())),
+		ResponseCondition: helpers.NullableString(remote.ResponseCondition.Get(), prior.ResponseCondition),
+		TLSCACert:         helpers.NullableString(remote.TLSCaCert.Get(), prior.TLSCACert),
+		TLSClientCert:     helpers.NullableString(remote.TLSClientCert.Get(), prior.TLSClientCert),
+		TLSClientKey:      helpers.NullableString(remote.TLSClientKey.Get(), prior.TLSClientKey),
+		TLSHostname:       helpers.NullableString(remote.TLSHostname.Get(), prior.TLSHostname),
+		Topic:             types.StringValue(remote.GetTopic()),
+		UseTLS:            types.BoolValue(remote.GetUseTLS() == fastly.LOGGINGUSETLS_use_tls),
+		User:              helpers.NullableString(remote.User, prior.User),
+	}
+}
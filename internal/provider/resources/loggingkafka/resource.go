@@ -0,0 +1,36 @@
+package loggingkafka
+
+import (
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/interfaces"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/registry"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/logging"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/schemas"
+)
+
+// NOTE: Kafka logging is supported by both VCL and Compute services.
+func init() {
+	registry.Register(registry.NestedResource{
+		Attribute: "logging_kafka",
+		New:       NewResource,
+		Schema:    schemas.LoggingKafka,
+	})
+}
+
+// NewResource returns a new resource entity.
+func NewResource() interfaces.Resource {
+	return logging.NewResource[models.LoggingKafka]("logging_kafka", Endpoint)
+}
+
+// Endpoint is the Kafka logging endpoint.
+var Endpoint logging.Endpoint[models.LoggingKafka] = kafka{}
+
+// kafka implements the Kafka logging endpoint API calls (see logging.Endpoint).
+type kafka struct{}
+
+// Name returns the name of the endpoint.
+func (kafka) Name(endpointData models.LoggingKafka) string {
+	return endpointData.Name.ValueString()
+}
+
+// NOTE: Schema defined in ../../schemas/logging_kafka.go
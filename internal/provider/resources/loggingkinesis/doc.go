@@ -0,0 +1,2 @@
+// Package loggingkinesis implements an Amazon Kinesis logging endpoint resource.
+package loggingkinesis
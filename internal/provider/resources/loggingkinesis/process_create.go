@@ -0,0 +1,58 @@
+package loggingkinesis
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/logging"
+)
+
+// Create creates the endpoint.
+func (kinesis) Create(
+	ctx context.Context,
+	api helpers.API,
+	service *helpers.Service,
+	endpointData models.LoggingKinesis,
+	diags *diag.Diagnostics,
+) error {
+	createErr := errors.New("failed to create Kinesis logging endpoint resource")
+
+	clientReq := api.Client.LoggingKinesisAPI.CreateLogKinesis(
+		api.ClientCtx,
+		service.ID,
+		service.Version,
+	)
+
+	clientReq.Name(endpointData.Name.ValueString())
+	clientReq.Topic(endpointData.Topic.ValueString())
+	clientReq.Region(fastly.AwsRegion(endpointData.Region.ValueString()))
+	clientReq.Format(endpointData.Format.ValueString())
+	clientReq.FormatVersion(int32(endpointData.FormatVersion.ValueInt64()))
+
+	logging.Optional(endpointData.AccessKey, clientReq.AccessKey)
+	logging.Optional(endpointData.IAMRole, clientReq.IamRole)
+	if !endpointData.Placement.IsNull() {
+		clientReq.Placement(fastly.LoggingPlacement(endpointData.Placement.ValueString()))
+	}
+	logging.Optional(endpointData.SecretKey, clientReq.SecretKey)
+
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly LoggingKinesisAPI.CreateLogKinesis error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to create Kinesis logging endpoint")
+		return createErr
+	}
+	defer httpResp.Body.Close()
+
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return createErr
+	}
+
+	return nil
+}
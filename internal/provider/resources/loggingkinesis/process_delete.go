@@ -0,0 +1,34 @@
+package loggingkinesis
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// Delete deletes the named endpoint.
+//
+// NOTE: The API client doesn't support updating a Kinesis endpoint, so a
+// modified endpoint is also deleted and created again (see logging.Resource).
+func (kinesis) Delete(
+	ctx context.Context,
+	api helpers.API,
+	service *helpers.Service,
+	name string,
+	diags *diag.Diagnostics,
+) error {
+	clientReq := api.Client.LoggingKinesisAPI.DeleteLogKinesis(api.ClientCtx, service.ID, service.Version, name)
+
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly LoggingKinesisAPI.DeleteLogKinesis error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to delete Kinesis logging endpoint")
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	return helpers.CheckStatus(ctx, httpResp, diags)
+}
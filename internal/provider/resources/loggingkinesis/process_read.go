@@ -0,0 +1,74 @@
+package loggingkinesis
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// List returns the endpoints of the service version.
+func (kinesis) List(
+	ctx context.Context,
+	api helpers.API,
+	service *helpers.Service,
+	prior func(name string) models.LoggingKinesis,
+	diags *diag.Diagnostics,
+) ([]models.LoggingKinesis, error) {
+	// NOTE: The endpoints are only decoded if they've changed since last read.
+	clientResp, httpResp, err := helpers.ReadConditional(api, func(clientCtx context.Context) ([]fastly.LoggingKinesisResponse, *http.Response, error) {
+		return api.Client.LoggingKinesisAPI.ListLogKinesis(clientCtx, service.ID, service.Version).Execute()
+	})
+	if err != nil {
+		tflog.Trace(ctx, "Fastly LoggingKinesisAPI.ListLogKinesis error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to list Kinesis logging endpoints")
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return nil, err
+	}
+
+	remoteEndpoints := make([]models.LoggingKinesis, 0, len(clientResp))
+	for _, remoteEndpoint := range clientResp {
+		remoteEndpoints = append(remoteEndpoints, endpoint(remoteEndpoint, prior(remoteEndpoint.GetName())))
+	}
+
+	return remoteEndpoints, nil
+}
+
+// endpoint returns the data model of a remote endpoint.
+//
+// NOTE: The API returns an empty string for an unset optional attribute, so
+// the prior state value (if any) is used to distinguish it from a null value
+// (see helpers.NullableString). An endpoint that isn't in the prior state has
+// a zero value prior, whose attributes are null.
+func endpoint(remote fastly.LoggingKinesisResponse, prior models.LoggingKinesis) models.LoggingKinesis {
+	formatVersion, _ := strconv.ParseInt(remote.GetFormatVersion(), 10, 64)
+
+	var placement *string
+	if p := remote.Placement.Get(); p != nil && *p != fastly.LOGGINGPLACEMENT_NULL {
+		s := string(*p)
+		placement = &s
+	}
+
+	return models.LoggingKinesis{
+		AccessKey:     helpers.NullableString(remote.AccessKey.Get(), prior.AccessKey),
+		Format:        types.StringValue(remote.GetFormat()),
+		FormatVersion: types.Int64Value(formatVersion),
+		IAMRole:       helpers.NullableString(remote.IamRole.Get(), prior.IAMRole),
+		Name:          types.StringValue(remote.GetName()),
+		Placement:     helpers.NullableString(placement, prior.Placement),
+		Region:        types.StringValue(string(remote.GetRegion())),
+		SecretKey:     helpers.NullableString(remote.SecretKey.Get(), prior.SecretKey),
+		Topic:         types.StringValue(remote.GetTopic()),
+	}
+}
@@ -0,0 +1,36 @@
+package loggingkinesis
+
+import (
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/interfaces"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/registry"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/logging"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/schemas"
+)
+
+// NOTE: Kinesis logging is supported by both VCL and Compute services.
+func init() {
+	registry.Register(registry.NestedResource{
+		Attribute: "logging_kinesis",
+		New:       NewResource,
+		Schema:    schemas.LoggingKinesis,
+	})
+}
+
+// NewResource returns a new resource entity.
+func NewResource() interfaces.Resource {
+	return logging.NewResource[models.LoggingKinesis]("logging_kinesis", Endpoint)
+}
+
+// Endpoint is the Kinesis logging endpoint.
+var Endpoint logging.Endpoint[models.LoggingKinesis] = kinesis{}
+
+// kinesis implements the Kinesis logging endpoint API calls (see logging.Endpoint).
+type kinesis struct{}
+
+// Name returns the name of the endpoint.
+func (kinesis) Name(endpointData models.LoggingKinesis) string {
+	return endpointData.Name.ValueString()
+}
+
+// NOTE: Schema defined in ../../schemas/logging_kinesis.go
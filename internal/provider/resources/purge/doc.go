@@ -0,0 +1,2 @@
+// Package purge implements a cache purge resource.
+package purge
@@ -0,0 +1,101 @@
+package purge
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Create is called when the provider must create a new resource.
+// Config and planned state values should be read from the CreateRequest.
+// New state values set on the CreateResponse.
+//
+// Creating the resource issues the purge.
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	api, finishSpan := r.newAPI(ctx, "Create")
+	defer finishSpan(&resp.Diagnostics)
+
+	var plan *models.Purge
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after plan population")
+		return
+	}
+
+	serviceID := plan.ServiceID.ValueString()
+
+	var softPurge int32
+	if plan.Soft.ValueBool() {
+		softPurge = 1
+	}
+
+	var (
+		httpResp *http.Response
+		err      error
+		endpoint string
+	)
+
+	switch {
+	case plan.All.ValueBool():
+		endpoint = "PurgeAll"
+		clientReq := api.Client.PurgeAPI.PurgeAll(api.ClientCtx, serviceID)
+		_, httpResp, err = clientReq.Execute()
+	case len(plan.SurrogateKeys) > 0:
+		keys := make([]string, 0, len(plan.SurrogateKeys))
+		for _, k := range plan.SurrogateKeys {
+			keys = append(keys, k.ValueString())
+		}
+		endpoint = "BulkPurgeTag"
+		clientReq := api.Client.PurgeAPI.BulkPurgeTag(api.ClientCtx, serviceID)
+		clientReq.SurrogateKey(strings.Join(keys, " "))
+		clientReq.FastlySoftPurge(softPurge)
+		_, httpResp, err = clientReq.Execute()
+	case !plan.URL.IsNull():
+		endpoint = "PurgeSingleURL"
+		clientReq := api.Client.PurgeAPI.PurgeSingleURL(api.ClientCtx, plan.URL.ValueString())
+		clientReq.FastlySoftPurge(softPurge)
+		_, httpResp, err = clientReq.Execute()
+	default:
+		// ConfigValidators ensures exactly one purge type is set.
+		// So this can only happen if `all` was explicitly set to false.
+		resp.Diagnostics.AddError(helpers.ErrorUser, "One of 'all', 'surrogate_keys' or 'url' must be set to purge content")
+		return
+	}
+
+	if err != nil {
+		tflog.Trace(ctx, fmt.Sprintf("Fastly PurgeAPI.%s error", endpoint), map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to purge content")
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	// NOTE: A URL purge isn't scoped to a service, so the URL identifies it.
+	target := serviceID
+	if !plan.URL.IsNull() {
+		target = plan.URL.ValueString()
+	}
+	plan.ID = types.StringValue(fmt.Sprintf("%s-%d", target, time.Now().UnixNano()))
+
+	// Save the planned changes into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Create", map[string]any{"state": helpers.LogState(plan)})
+}
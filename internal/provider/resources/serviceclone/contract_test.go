@@ -0,0 +1,91 @@
+package serviceclone
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/mockapi"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// TestContractClone validates the settings (and HTTP/3) of the source service
+// version are copied into a new service, and an active clone is only deleted
+// when forced.
+func TestContractClone(t *testing.T) {
+	server, api, sourceID := mockapi.NewService(t)
+
+	settingsReq := api.Client.SettingsAPI.UpdateServiceSettings(api.ClientCtx, sourceID, 1)
+	settingsReq.GeneralDefaultHost("origin.example.com")
+	settingsReq.GeneralDefaultTTL(60)
+	_, httpResp, err := settingsReq.Execute()
+	if err != nil {
+		t.Fatalf("failed to set mock service settings: %s", err)
+	}
+	httpResp.Body.Close()
+	_, httpResp, err = api.Client.HTTP3API.CreateHTTP3(api.ClientCtx, sourceID, 1).Execute()
+	if err != nil {
+		t.Fatalf("failed to enable mock HTTP/3: %s", err)
+	}
+	httpResp.Body.Close()
+
+	plan := &models.ServiceClone{
+		Comment:         types.StringValue("copy"),
+		Domains:         types.SetValueMust(types.StringType, []attr.Value{types.StringValue("tenant.example.com")}),
+		ForceDestroy:    types.BoolValue(false),
+		Name:            types.StringValue("tenant"),
+		SourceServiceID: types.StringValue(sourceID),
+		SourceVersion:   types.Int64Unknown(),
+	}
+
+	var diags diag.Diagnostics
+	if err := clone(context.Background(), api, plan, []string{"tenant.example.com"}, &diags); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+	if plan.SourceVersion.ValueInt64() != 1 || plan.Version.ValueInt64() != 1 {
+		t.Errorf("expected source version 1 and version 1, got %s and %s", plan.SourceVersion, plan.Version)
+	}
+
+	cloned := server.Service(plan.ID.ValueString())
+	if cloned == nil || cloned.ID == sourceID {
+		t.Fatalf("expected a new service, got %+v", cloned)
+	}
+	if got := cloned.Versions[0].Settings; got.DefaultHost != "origin.example.com" || got.DefaultTTL != 60 {
+		t.Errorf("expected the source settings to be copied, got %+v", got)
+	}
+	if !cloned.Versions[0].HTTP3 {
+		t.Error("expected HTTP/3 to be enabled, as it is for the source version")
+	}
+	if domains := cloned.Versions[0].Domains; len(domains) != 1 || domains[0].Name != "tenant.example.com" {
+		t.Errorf("expected the configured domain, got %+v", domains)
+	}
+
+	// A missing source version is rejected before a service is created.
+	missing := *plan
+	missing.SourceVersion = types.Int64Value(2)
+	diags = nil
+	if err := clone(context.Background(), api, &missing, nil, &diags); err == nil {
+		t.Fatal("expected error for missing source version")
+	}
+
+	// An active clone isn't deleted unless forced.
+	if _, httpResp, err = api.Client.VersionAPI.ActivateServiceVersion(api.ClientCtx, cloned.ID, 1).Execute(); err != nil {
+		t.Fatalf("failed to activate mock service: %s", err)
+	}
+	httpResp.Body.Close()
+	diags = nil
+	if err := deleteClone(context.Background(), api, plan, &diags); err == nil {
+		t.Fatal("expected error deleting an active service")
+	}
+	plan.ForceDestroy = types.BoolValue(true)
+	diags = nil
+	if err := deleteClone(context.Background(), api, plan, &diags); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+	if server.Service(cloned.ID).DeletedAt == nil {
+		t.Error("expected the cloned service to be deleted")
+	}
+}
@@ -0,0 +1,2 @@
+// Package serviceclone implements a resource that copies a service into a new service.
+package serviceclone
@@ -0,0 +1,237 @@
+package serviceclone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Create is called when the provider must create a new resource.
+// Config and planned state values should be read from the CreateRequest.
+// New state values set on the CreateResponse.
+//
+// Creating the resource creates a new service and copies the configuration of
+// the source service version into it.
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	api, finishSpan := r.newAPI(ctx, "Create")
+	defer finishSpan(&resp.Diagnostics)
+
+	var plan *models.ServiceClone
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after plan population")
+		return
+	}
+
+	var domains []string
+	if !plan.Domains.IsNull() {
+		resp.Diagnostics.Append(plan.Domains.ElementsAs(ctx, &domains, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if err := clone(ctx, api, plan, domains, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	// Save the planned changes into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Create", map[string]any{"state": helpers.LogState(plan)})
+}
+
+// clone creates a new service holding a copy of the source service version,
+// and sets the computed attributes of the plan.
+//
+// NOTE: Only the versioned settings (and HTTP/3) are copied. The domains of
+// the source service can't be copied, as a domain can only belong to one
+// service.
+func clone(ctx context.Context, api helpers.API, plan *models.ServiceClone, domains []string, diags *diag.Diagnostics) error {
+	sourceID := plan.SourceServiceID.ValueString()
+
+	sourceVersion, err := readSourceVersion(ctx, api, sourceID, int32(plan.SourceVersion.ValueInt64()), diags)
+	if err != nil {
+		return err
+	}
+
+	settings, httpResp, err := api.Client.SettingsAPI.GetServiceSettings(api.ClientCtx, sourceID, sourceVersion).Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly SettingsAPI.GetServiceSettings error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, fmt.Sprintf("Unable to read the settings of service '%s' version %d", sourceID, sourceVersion))
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	http3, err := readHTTP3(ctx, api, sourceID, sourceVersion, diags)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: The comment is always sent, even if it's an empty string.
+	// This is how a user opts out of the default comment.
+	clientReq := api.Client.ServiceAPI.CreateService(api.ClientCtx)
+	clientReq.Comment(plan.Comment.ValueString())
+	clientReq.Name(plan.Name.ValueString())
+	clientReq.ResourceType("vcl")
+
+	service, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly ServiceAPI.CreateService error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to create service")
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	versions := service.GetVersions()
+	if service.GetID() == "" || len(versions) == 0 {
+		tflog.Trace(ctx, helpers.ErrorAPI, map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		diags.AddError(helpers.ErrorAPI, "No Service ID or versions were returned")
+		return errors.New("failed to create service: no Service ID or versions returned")
+	}
+	serviceID := service.GetID()
+	serviceVersion := versions[0].GetNumber()
+
+	if err := copySettings(ctx, api, serviceID, serviceVersion, settings, diags); err != nil {
+		return err
+	}
+	if http3 {
+		if err := enableHTTP3(ctx, api, serviceID, serviceVersion, diags); err != nil {
+			return err
+		}
+	}
+
+	for _, domain := range domains {
+		clientReq := api.Client.DomainAPI.CreateDomain(api.ClientCtx, serviceID, serviceVersion)
+		clientReq.Name(domain)
+
+		_, httpResp, err := clientReq.Execute()
+		if err != nil {
+			tflog.Trace(ctx, "Fastly DomainAPI.CreateDomain error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+			helpers.APIError(httpResp, err, diags, fmt.Sprintf("Unable to create domain '%s'", domain))
+			return err
+		}
+		defer httpResp.Body.Close()
+	}
+
+	plan.ID = types.StringValue(serviceID)
+	plan.SourceVersion = types.Int64Value(int64(sourceVersion))
+	plan.Version = types.Int64Value(int64(serviceVersion))
+
+	return nil
+}
+
+// readSourceVersion returns the source service version to copy.
+//
+// If version is zero, the active version is returned (falling back to the
+// latest version if the service has never been activated).
+func readSourceVersion(ctx context.Context, api helpers.API, serviceID string, version int32, diags *diag.Diagnostics) (int32, error) {
+	clientResp, httpResp, err := api.Client.ServiceAPI.GetServiceDetail(api.ClientCtx, serviceID).Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly ServiceAPI.GetServiceDetail error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, fmt.Sprintf("Unable to read the source service '%s'", serviceID))
+		return 0, err
+	}
+	defer httpResp.Body.Close()
+
+	if deletedAt, _ := clientResp.GetDeletedAtOk(); deletedAt != nil {
+		diags.AddError(helpers.ErrorUser, fmt.Sprintf("The source service '%s' has been deleted", serviceID))
+		return 0, fmt.Errorf("source service '%s' has been deleted", serviceID)
+	}
+
+	versions := clientResp.GetVersions()
+	if len(versions) == 0 {
+		diags.AddError(helpers.ErrorAPI, fmt.Sprintf("No versions were returned for the source service '%s'", serviceID))
+		return 0, fmt.Errorf("failed to find any versions for service '%s'", serviceID)
+	}
+
+	if version != 0 {
+		for _, v := range versions {
+			if v.GetNumber() == version {
+				return version, nil
+			}
+		}
+		diags.AddError(helpers.ErrorUser, fmt.Sprintf("The source service '%s' has no version %d", serviceID, version))
+		return 0, fmt.Errorf("failed to find version '%d' for service '%s'", version, serviceID)
+	}
+
+	for _, v := range versions {
+		if v.GetActive() {
+			return v.GetNumber(), nil
+		}
+	}
+	return versions[len(versions)-1].GetNumber(), nil
+}
+
+// copySettings sets the versioned settings of the cloned service version.
+//
+// NOTE: There is no 'create service settings' API, only 'update'.
+func copySettings(ctx context.Context, api helpers.API, serviceID string, serviceVersion int32, settings *fastly.SettingsResponse, diags *diag.Diagnostics) error {
+	clientReq := api.Client.SettingsAPI.UpdateServiceSettings(api.ClientCtx, serviceID, serviceVersion)
+	clientReq.GeneralDefaultHost(settings.GetGeneralDefaultHost())
+	if ptr, ok := settings.GetGeneralDefaultTTLOk(); ok {
+		clientReq.GeneralDefaultTTL(*ptr)
+	}
+	if ptr, ok := settings.GetGeneralStaleIfErrorOk(); ok {
+		clientReq.GeneralStaleIfError(*ptr)
+	}
+	if ptr, ok := settings.GetGeneralStaleIfErrorTTLOk(); ok {
+		clientReq.GeneralStaleIfErrorTTL(*ptr)
+	}
+
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly SettingsAPI.UpdateServiceSettings error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to set service settings")
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	return nil
+}
+
+// readHTTP3 indicates if HTTP/3 is enabled for the service version.
+//
+// NOTE: The API returns a 404 if HTTP/3 isn't enabled.
+func readHTTP3(ctx context.Context, api helpers.API, serviceID string, serviceVersion int32, diags *diag.Diagnostics) (bool, error) {
+	_, httpResp, err := api.Client.HTTP3API.GetHTTP3(api.ClientCtx, serviceID, serviceVersion).Execute()
+	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			return false, nil
+		}
+		tflog.Trace(ctx, "Fastly HTTP3API.GetHTTP3 error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, fmt.Sprintf("Unable to read the HTTP/3 setting of service '%s' version %d", serviceID, serviceVersion))
+		return false, err
+	}
+	defer httpResp.Body.Close()
+
+	return true, nil
+}
+
+// enableHTTP3 enables HTTP/3 for the cloned service version.
+func enableHTTP3(ctx context.Context, api helpers.API, serviceID string, serviceVersion int32, diags *diag.Diagnostics) error {
+	_, httpResp, err := api.Client.HTTP3API.CreateHTTP3(api.ClientCtx, serviceID, serviceVersion).Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly HTTP3API.CreateHTTP3 error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, fmt.Sprintf("Unable to enable HTTP/3 for service version %d", serviceVersion))
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package serviceclone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Delete is called when the provider must delete the resource.
+// Config values may be read from the DeleteRequest.
+//
+// If the cloned service has been activated (e.g. by `fastly_service_promotion`)
+// it's only deactivated and deleted when `force_destroy` is true.
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	api, finishSpan := r.newAPI(ctx, "Delete")
+	defer finishSpan(&resp.Diagnostics)
+
+	var state *models.ServiceClone
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after state population")
+		return
+	}
+
+	// The domains might be added to another service in the same apply, which
+	// waits for the clone to release them.
+	for _, domain := range state.Domains.Elements() {
+		if name, ok := domain.(types.String); ok {
+			helpers.ExpectDomainRelease(name.ValueString())
+		}
+	}
+
+	if err := deleteClone(ctx, api, state, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	tflog.Debug(ctx, "Delete", map[string]any{"state": helpers.LogState(state)})
+}
+
+// deleteClone deletes the cloned service, deactivating it first if
+// `force_destroy` is true.
+func deleteClone(ctx context.Context, api helpers.API, state *models.ServiceClone, diags *diag.Diagnostics) error {
+	serviceID := state.ID.ValueString()
+
+	if state.ForceDestroy.ValueBool() {
+		clientResp, httpResp, err := api.Client.ServiceAPI.GetServiceDetail(api.ClientCtx, serviceID).Execute()
+		if err != nil {
+			// The service was deleted outside of Terraform, so there's nothing to do.
+			if helpers.IsNotFound(httpResp) {
+				return nil
+			}
+			tflog.Trace(ctx, "Fastly ServiceAPI.GetServiceDetail error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+			helpers.APIError(httpResp, err, diags, "Unable to retrieve service details")
+			return err
+		}
+		defer httpResp.Body.Close()
+
+		if deletedAt, _ := clientResp.GetDeletedAtOk(); deletedAt != nil {
+			return nil
+		}
+
+		if activeVersion := clientResp.GetActiveVersion().Number; activeVersion != nil && *activeVersion != 0 {
+			_, httpResp, err := api.Client.VersionAPI.DeactivateServiceVersion(api.ClientCtx, serviceID, *activeVersion).Execute()
+			if err != nil {
+				tflog.Trace(ctx, "Fastly VersionAPI.DeactivateServiceVersion error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+				helpers.APIError(httpResp, err, diags, fmt.Sprintf("Unable to deactivate service version %d", *activeVersion))
+				return err
+			}
+			defer httpResp.Body.Close()
+		}
+	}
+
+	_, httpResp, err := api.Client.ServiceAPI.DeleteService(api.ClientCtx, serviceID).Execute()
+	if err != nil {
+		// The service was deleted outside of Terraform, so there's nothing to do.
+		if helpers.IsNotFound(httpResp) {
+			return nil
+		}
+		tflog.Trace(ctx, "Fastly ServiceAPI.DeleteService error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to delete service (set `force_destroy` to delete an active service)")
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	// The domains can now be created by another service.
+	for _, domain := range state.Domains.Elements() {
+		if name, ok := domain.(types.String); ok {
+			helpers.ReleaseDomain(name.ValueString())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package serviceclone
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Read is called when the provider must read resource values in order to update state.
+// Planned state values should be read from the ReadRequest.
+// New state values set on the ReadResponse.
+//
+// NOTE: Only the versionless attributes (`name` and `comment`) are refreshed.
+// The copied configuration isn't compared with the source service, as the
+// source service is expected to change after it has been cloned.
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	api, finishSpan := r.newAPI(ctx, "Read")
+	defer finishSpan(&resp.Diagnostics)
+
+	var state *models.ServiceClone
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after state population")
+		return
+	}
+
+	clientResp, httpResp, err := api.Client.ServiceAPI.GetServiceDetail(api.ClientCtx, state.ID.ValueString()).Execute()
+	if err != nil {
+		// The service no longer exists, so we remove it from the state and the
+		// next plan will clone the source service again.
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, "Fastly service not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		tflog.Trace(ctx, "Fastly ServiceAPI.GetServiceDetail error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to retrieve service details")
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if deletedAt, _ := clientResp.GetDeletedAtOk(); deletedAt != nil {
+		tflog.Warn(ctx, "Fastly service deleted, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Comment = types.StringValue(clientResp.GetComment())
+	state.Name = types.StringValue(clientResp.GetName())
+
+	// Save the updated state data back into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Read", map[string]any{"state": helpers.LogState(state)})
+}
@@ -0,0 +1,122 @@
+package servicepromotion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/mockapi"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// TestContractPromote validates the preconditions are checked before a version
+// is activated, and the previously active version is recorded.
+func TestContractPromote(t *testing.T) {
+	server, api, serviceID := mockapi.NewService(t)
+
+	newPlan := func(version int64, requireLocked bool) *models.ServicePromotion {
+		return &models.ServicePromotion{
+			PreviousVersion: types.Int64Unknown(),
+			RequireLocked:   types.BoolValue(requireLocked),
+			ServiceID:       types.StringValue(serviceID),
+			Validate:        types.BoolValue(true),
+			Version:         types.Int64Value(version),
+		}
+	}
+
+	// An unlocked version is rejected when a lock is required.
+	var diags diag.Diagnostics
+	if err := promote(context.Background(), api, newPlan(1, true), &diags); err == nil {
+		t.Fatal("expected error for unlocked version")
+	}
+	if server.Service(serviceID).Versions[0].Active {
+		t.Fatal("expected unlocked version not to be activated")
+	}
+
+	// A failed validation prevents the activation.
+	server.FailNext(http.MethodGet, "/service/"+serviceID+"/version/1/validate", http.StatusBadRequest)
+	diags = nil
+	if err := promote(context.Background(), api, newPlan(1, false), &diags); err == nil {
+		t.Fatal("expected error for failed validation")
+	}
+	if server.Service(serviceID).Versions[0].Active {
+		t.Fatal("expected invalid version not to be activated")
+	}
+
+	// The first promotion has no previous version.
+	plan := newPlan(1, false)
+	diags = nil
+	if err := promote(context.Background(), api, plan, &diags); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+	if !plan.Active.ValueBool() || !plan.PreviousVersion.IsNull() {
+		t.Errorf("expected active version without previous version, got: %#v", plan)
+	}
+
+	_, httpResp, err := api.Client.VersionAPI.CloneServiceVersion(api.ClientCtx, serviceID, 1).Execute()
+	if err != nil {
+		t.Fatalf("failed to clone mock version: %s", err)
+	}
+	httpResp.Body.Close()
+
+	// A subsequent promotion records the version it replaced.
+	plan = newPlan(2, false)
+	diags = nil
+	if err := promote(context.Background(), api, plan, &diags); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+	if got := plan.PreviousVersion.ValueInt64(); got != 1 {
+		t.Errorf("expected previous version 1, got: %d", got)
+	}
+	if versions := server.Service(serviceID).Versions; versions[0].Active || !versions[1].Active {
+		t.Error("expected only version 2 to be active")
+	}
+}
+
+// TestContractPromoteConflict validates a promotion that conflicts with another
+// in-flight activation is retried, and waits for the deployment.
+func TestContractPromoteConflict(t *testing.T) {
+	backoff, interval := helpers.ActivationRetryBackoff, helpers.DeploymentPollInterval
+	helpers.ActivationRetryBackoff, helpers.DeploymentPollInterval = time.Millisecond, time.Millisecond
+	t.Cleanup(func() { helpers.ActivationRetryBackoff, helpers.DeploymentPollInterval = backoff, interval })
+
+	server, api, serviceID := mockapi.NewService(t)
+	activatePath := "/service/" + serviceID + "/version/1/activate"
+	server.FailNext(http.MethodPut, activatePath, http.StatusConflict)
+
+	plan := &models.ServicePromotion{
+		PreviousVersion:   types.Int64Unknown(),
+		ServiceID:         types.StringValue(serviceID),
+		Version:           types.Int64Value(1),
+		WaitForDeployment: types.BoolValue(true),
+	}
+
+	var diags diag.Diagnostics
+	if err := promote(context.Background(), api, plan, &diags); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+	if !plan.Active.ValueBool() || !server.Service(serviceID).Versions[0].Active {
+		t.Error("expected version 1 to be active")
+	}
+
+	var attempts, deploymentChecks int
+	for _, r := range server.Requests() {
+		switch {
+		case r.Method == http.MethodPut && r.Path == activatePath:
+			attempts++
+		case r.Method == http.MethodGet && r.Path == "/service/"+serviceID+"/version/1/generated_vcl":
+			deploymentChecks++
+		}
+	}
+	if attempts != 2 {
+		t.Errorf("want 2 activation attempts, got: %d", attempts)
+	}
+	if deploymentChecks == 0 {
+		t.Error("want the deployment to be waited for")
+	}
+}
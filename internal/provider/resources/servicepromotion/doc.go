@@ -0,0 +1,2 @@
+// Package servicepromotion implements a service version promotion resource.
+package servicepromotion
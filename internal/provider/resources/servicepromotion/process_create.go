@@ -0,0 +1,159 @@
+package servicepromotion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Create is called when the provider must create a new resource.
+// Config and planned state values should be read from the CreateRequest.
+// New state values set on the CreateResponse.
+//
+// Creating the resource promotes (activates) the service version.
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	api, finishSpan := r.newAPI(ctx, "Create")
+	defer finishSpan(&resp.Diagnostics)
+
+	var plan *models.ServicePromotion
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after plan population")
+		return
+	}
+
+	if err := promote(ctx, api, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	plan.ID = plan.ServiceID
+
+	// Save the planned changes into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Create", map[string]any{"state": helpers.LogState(plan)})
+}
+
+// promote checks the version preconditions (including the activation window)
+// and then activates the version (see helpers.ActivateService).
+//
+// NOTE: If the version is already active it isn't activated again, and the
+// previous version is left unchanged (as nothing was promoted).
+func promote(ctx context.Context, api helpers.API, plan *models.ServicePromotion, diags *diag.Diagnostics) error {
+	serviceID := plan.ServiceID.ValueString()
+	serviceVersion := int32(plan.Version.ValueInt64())
+
+	clientResp, httpResp, err := api.Client.VersionAPI.GetServiceVersion(api.ClientCtx, serviceID, serviceVersion).Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly VersionAPI.GetServiceVersion error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, fmt.Sprintf("Unable to read service version %d", serviceVersion))
+		return err
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return err
+	}
+
+	if clientResp.GetActive() {
+		tflog.Debug(ctx, "Service version already active", map[string]any{"service_id": serviceID, "version": serviceVersion})
+		plan.Active = types.BoolValue(true)
+		if plan.PreviousVersion.IsUnknown() {
+			plan.PreviousVersion = types.Int64Null()
+		}
+		return nil
+	}
+
+	if plan.RequireLocked.ValueBool() && !clientResp.GetLocked() {
+		err := fmt.Errorf("service version %d is not locked", serviceVersion)
+		diags.AddError(helpers.ErrorUser, fmt.Sprintf("Unable to promote service version %d as `require_locked` is set and the version is not locked", serviceVersion))
+		return err
+	}
+
+	if err := helpers.CheckActivationWindow(plan.ActivationWindow, plan.ActivationWindowOverride, time.Now(), diags); err != nil {
+		return err
+	}
+
+	if plan.Validate.ValueBool() {
+		if err := validateVersion(ctx, api, serviceID, serviceVersion, diags); err != nil {
+			return err
+		}
+	}
+
+	previous, err := activeVersion(ctx, api, serviceID, diags)
+	if err != nil {
+		return err
+	}
+
+	opts := helpers.ActivationOptions{WaitForDeployment: plan.WaitForDeployment.ValueBool()}
+	if _, err := helpers.ActivateService(ctx, serviceID, serviceVersion, opts, api, diags); err != nil {
+		return err
+	}
+
+	plan.Active = types.BoolValue(true)
+	plan.PreviousVersion = types.Int64Null()
+	if previous != 0 {
+		plan.PreviousVersion = types.Int64Value(int64(previous))
+	}
+
+	return nil
+}
+
+// validateVersion returns an error if the service version fails validation.
+func validateVersion(ctx context.Context, api helpers.API, serviceID string, serviceVersion int32, diags *diag.Diagnostics) error {
+	clientResp, httpResp, err := api.Client.VersionAPI.ValidateServiceVersion(api.ClientCtx, serviceID, serviceVersion).Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly VersionAPI.ValidateServiceVersion error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, fmt.Sprintf("Unable to validate service version %d", serviceVersion))
+		return err
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return err
+	}
+
+	if status := clientResp.GetStatus(); status != "ok" {
+		msg := fmt.Sprintf("Service version %d failed validation (status: %s)", serviceVersion, status)
+		if detail, ok := clientResp.AdditionalProperties["msg"].(string); ok && detail != "" {
+			msg = fmt.Sprintf("%s: %s", msg, detail)
+		}
+		diags.AddError(helpers.ErrorUser, msg)
+		return fmt.Errorf("service version %d failed validation", serviceVersion)
+	}
+
+	return nil
+}
+
+// activeVersion returns the active service version (zero if none is active).
+func activeVersion(ctx context.Context, api helpers.API, serviceID string, diags *diag.Diagnostics) (int32, error) {
+	clientResp, httpResp, err := api.Client.ServiceAPI.GetServiceDetail(api.ClientCtx, serviceID).Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly ServiceAPI.GetServiceDetail error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to read service details")
+		return 0, err
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return 0, err
+	}
+
+	for _, v := range clientResp.GetVersions() {
+		if v.GetActive() {
+			return v.GetNumber(), nil
+		}
+	}
+	return 0, nil
+}
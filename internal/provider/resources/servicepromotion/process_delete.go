@@ -0,0 +1,18 @@
+package servicepromotion
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Delete is called when the provider must delete the resource.
+// Config values may be read from the DeleteRequest.
+//
+// Deleting the promotion doesn't deactivate the version (deactivating would
+// take the service out of production), so it only removes it from the state,
+// which the framework does automatically when execution completes without error.
+func (r *Resource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete: promotion removed from state")
+}
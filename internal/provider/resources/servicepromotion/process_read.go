@@ -0,0 +1,64 @@
+package servicepromotion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Read is called when the provider must read resource values in order to update state.
+// Planned state values should be read from the ReadRequest.
+// New state values set on the ReadResponse.
+//
+// NOTE: If another version has been activated outside of Terraform, `active`
+// is set to `false` but the version isn't promoted again. To promote it again,
+// replace the resource (e.g. `terraform apply -replace`).
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	api, finishSpan := r.newAPI(ctx, "Read")
+	defer finishSpan(&resp.Diagnostics)
+
+	var state *models.ServicePromotion
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after state population")
+		return
+	}
+
+	serviceID := state.ServiceID.ValueString()
+	serviceVersion := int32(state.Version.ValueInt64())
+
+	clientResp, httpResp, err := api.Client.VersionAPI.GetServiceVersion(api.ClientCtx, serviceID, serviceVersion).Execute()
+	if err != nil {
+		// The service (or version) no longer exists, so we remove it from the
+		// state and the next plan will promote it again (or fail to).
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, "Fastly service version not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		tflog.Trace(ctx, "Fastly VersionAPI.GetServiceVersion error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, fmt.Sprintf("Unable to read service version %d", serviceVersion))
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	state.Active = types.BoolValue(clientResp.GetActive())
+
+	// Save the updated state data back into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Read", map[string]any{"state": helpers.LogState(state)})
+}
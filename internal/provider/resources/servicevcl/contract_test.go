@@ -0,0 +1,697 @@
+package servicevcl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/mockapi"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/registry"
+)
+
+// TestContractUpdateServiceSettings validates a null default host is sent as
+// an empty string, so a removed default host is cleared by the API.
+func TestContractUpdateServiceSettings(t *testing.T) {
+	server, api, serviceID := mockapi.NewService(t)
+
+	plan := &models.ServiceVCL{
+		DefaultHost:     types.StringNull(),
+		DefaultTTL:      types.Int64Value(60),
+		ID:              types.StringValue(serviceID),
+		StaleIfError:    types.BoolValue(true),
+		StaleIfErrorTTL: types.Int64Value(120),
+		Version:         types.Int64Value(1),
+	}
+
+	var diags diag.Diagnostics
+	if err := updateServiceSettings(context.Background(), plan, &diags, api); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+
+	var found bool
+	for _, req := range server.Requests() {
+		if req.Method != http.MethodPut || req.Path != "/service/"+serviceID+"/version/1/settings" {
+			continue
+		}
+		found = true
+		want := map[string]string{
+			"general.default_host":       "",
+			"general.default_ttl":        "60",
+			"general.stale_if_error":     "true",
+			"general.stale_if_error_ttl": "120",
+		}
+		for k, v := range want {
+			if !req.Form.Has(k) || req.Form.Get(k) != v {
+				t.Errorf("want form field %s=%q, got: %q (present: %t)", k, v, req.Form.Get(k), req.Form.Has(k))
+			}
+		}
+	}
+	if !found {
+		t.Fatal("expected an update settings request")
+	}
+
+	if got := server.Service(serviceID).Versions[0].Settings.DefaultTTL; got != 60 {
+		t.Errorf("want default_ttl 60, got: %d", got)
+	}
+}
+
+// TestContractActivateServiceConflict validates a conflicting activation is
+// retried, and an activation that keeps conflicting fails once the maximum
+// number of retries is reached (unless an activation timeout allows further
+// retries). Any other error isn't retried.
+func TestContractActivateServiceConflict(t *testing.T) {
+	backoff := helpers.ActivationRetryBackoff
+	helpers.ActivationRetryBackoff = time.Millisecond
+	t.Cleanup(func() { helpers.ActivationRetryBackoff = backoff })
+
+	for name, tc := range map[string]struct {
+		failures     int
+		status       int
+		opts         helpers.ActivationOptions
+		wantAttempts int
+		wantErr      bool
+	}{
+		"409 then success": {
+			failures:     1,
+			status:       http.StatusConflict,
+			wantAttempts: 2,
+		},
+		"409 until the last retry": {
+			failures:     helpers.ActivationMaxRetries,
+			status:       http.StatusConflict,
+			wantAttempts: helpers.ActivationMaxRetries + 1,
+		},
+		"retries exhausted": {
+			failures:     helpers.ActivationMaxRetries + 1,
+			status:       http.StatusConflict,
+			wantAttempts: helpers.ActivationMaxRetries + 1,
+			wantErr:      true,
+		},
+		"activation timeout retries beyond the maximum retries": {
+			failures:     helpers.ActivationMaxRetries + 2,
+			status:       http.StatusConflict,
+			opts:         helpers.ActivationOptions{Interval: time.Millisecond, Timeout: time.Minute},
+			wantAttempts: helpers.ActivationMaxRetries + 3,
+		},
+		"other errors aren't retried": {
+			failures:     1,
+			status:       http.StatusInternalServerError,
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server, api, serviceID := mockapi.NewService(t)
+			activatePath := "/service/" + serviceID + "/version/1/activate"
+
+			for i := 0; i < tc.failures; i++ {
+				server.FailNext(http.MethodPut, activatePath, tc.status)
+			}
+
+			var diags diag.Diagnostics
+			version, err := helpers.ActivateService(context.Background(), serviceID, 1, tc.opts, api, &diags)
+			if tc.wantErr {
+				if err == nil || !diags.HasError() {
+					t.Errorf("want an error, got: %v", err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %s (%v)", err, diags)
+				}
+				if version != 1 {
+					t.Errorf("want version 1, got: %d", version)
+				}
+			}
+			if active := server.Service(serviceID).Versions[0].Active; active == tc.wantErr {
+				t.Errorf("want version 1 active %t, got: %t", !tc.wantErr, active)
+			}
+
+			var attempts int
+			for _, r := range server.Requests() {
+				if r.Method == http.MethodPut && r.Path == activatePath {
+					attempts++
+				}
+			}
+			if attempts != tc.wantAttempts {
+				t.Errorf("want %d activation attempts, got: %d", tc.wantAttempts, attempts)
+			}
+		})
+	}
+}
+
+// TestContractWaitForDeployment validates the generated VCL is polled until
+// it's available, and that a version that isn't active times out.
+func TestContractWaitForDeployment(t *testing.T) {
+	interval := helpers.DeploymentPollInterval
+	helpers.DeploymentPollInterval = time.Millisecond
+	t.Cleanup(func() { helpers.DeploymentPollInterval = interval })
+
+	server, api, serviceID := mockapi.NewService(t)
+	generatedPath := "/service/" + serviceID + "/version/1/generated_vcl"
+
+	server.FailNext(http.MethodGet, generatedPath, http.StatusNotFound)
+
+	var diags diag.Diagnostics
+	opts := helpers.ActivationOptions{WaitForDeployment: true}
+	if _, err := helpers.ActivateService(context.Background(), serviceID, 1, opts, api, &diags); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+
+	var polls int
+	for _, r := range server.Requests() {
+		if r.Method == http.MethodGet && r.Path == generatedPath {
+			polls++
+		}
+	}
+	if polls != 2 {
+		t.Errorf("want the generated VCL polled twice, got: %d", polls)
+	}
+
+	server, api, serviceID = mockapi.NewService(t)
+	diags = nil
+	opts = helpers.ActivationOptions{DeploymentTimeout: 10 * time.Millisecond}
+	if err := helpers.WaitForDeployment(context.Background(), serviceID, 1, opts, api, &diags); !errors.Is(err, helpers.ErrWaitTimeout) || !diags.HasError() {
+		t.Errorf("want a timeout for a version that isn't active, got: %v", err)
+	}
+}
+
+// TestContractServiceDeleted validates a deleted service is detected.
+func TestContractServiceDeleted(t *testing.T) {
+	_, api, serviceID := mockapi.NewService(t)
+
+	var diags diag.Diagnostics
+	if deleted, err := serviceDeleted(context.Background(), api, serviceID, &diags); err != nil || deleted {
+		t.Fatalf("want an existing service, got deleted %t (error: %v)", deleted, err)
+	}
+
+	clientReq := api.Client.ServiceAPI.DeleteService(api.ClientCtx, serviceID)
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		t.Fatalf("failed to delete mock service: %s", err)
+	}
+	httpResp.Body.Close()
+
+	if deleted, err := serviceDeleted(context.Background(), api, serviceID, &diags); err != nil || !deleted {
+		t.Errorf("want a deleted service (deleted_at), got deleted %t (error: %v)", deleted, err)
+	}
+	if deleted, err := serviceDeleted(context.Background(), api, "unknown", &diags); err != nil || !deleted {
+		t.Errorf("want a deleted service (404), got deleted %t (error: %v)", deleted, err)
+	}
+}
+
+// TestContractServicesNamed validates services with a duplicate name are found
+// (excluding the service being planned).
+func TestContractServicesNamed(t *testing.T) {
+	server, api, serviceID := mockapi.NewService(t)
+	duplicateID := server.AddService(t, "test")
+
+	var diags diag.Diagnostics
+	ids, err := servicesNamed(context.Background(), api, "test", serviceID, &diags)
+	if err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+	if len(ids) != 1 || ids[0] != duplicateID {
+		t.Errorf("expected only the other service to be found, got %v", ids)
+	}
+
+	ids, err = servicesNamed(context.Background(), api, "unique", "", &diags)
+	if err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+	if len(ids) != 0 {
+		t.Errorf("expected no services to be found, got %v", ids)
+	}
+}
+
+// TestContractAdoptService validates an existing service with the planned name
+// is adopted by cloning its active version (without the existing domains), and
+// that an ambiguous name isn't adopted.
+func TestContractAdoptService(t *testing.T) {
+	server, api, serviceID := mockapi.NewService(t)
+
+	domainReq := api.Client.DomainAPI.CreateDomain(api.ClientCtx, serviceID, 1)
+	domainReq.Name("old.example.com")
+	_, httpResp, err := domainReq.Execute()
+	if err != nil {
+		t.Fatalf("failed to create mock domain: %s", err)
+	}
+	httpResp.Body.Close()
+	dictionaryReq := api.Client.DictionaryAPI.CreateDictionary(api.ClientCtx, serviceID, 1)
+	dictionaryReq.Name("old_dictionary")
+	_, httpResp, err = dictionaryReq.Execute()
+	if err != nil {
+		t.Fatalf("failed to create mock dictionary: %s", err)
+	}
+	httpResp.Body.Close()
+	kafkaReq := api.Client.LoggingKafkaAPI.CreateLogKafka(api.ClientCtx, serviceID, 1)
+	kafkaReq.Name("old_kafka")
+	kafkaReq.Brokers("broker.example.com:9093")
+	kafkaReq.Topic("logs")
+	_, httpResp, err = kafkaReq.Execute()
+	if err != nil {
+		t.Fatalf("failed to create mock kafka endpoint: %s", err)
+	}
+	httpResp.Body.Close()
+	_, httpResp, err = api.Client.VersionAPI.ActivateServiceVersion(api.ClientCtx, serviceID, 1).Execute()
+	if err != nil {
+		t.Fatalf("failed to activate mock service: %s", err)
+	}
+	httpResp.Body.Close()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	(&Resource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	tfPlan := tfsdk.Plan{Schema: schemaResp.Schema}
+	nestedResources := registry.NestedResources(helpers.ServiceTypeVCL)
+
+	plan := &models.ServiceVCL{
+		Comment: types.StringValue("Managed by Terraform"),
+		Name:    types.StringValue("test"),
+	}
+
+	var diags diag.Diagnostics
+	id, version, lastActive, found, err := adoptService(ctx, plan, tfPlan, nestedResources, &diags, api)
+	if err != nil || !found {
+		t.Fatalf("expected the service to be adopted, got found %t (error: %v)", found, err)
+	}
+	if id != serviceID || version != 2 || lastActive != 1 {
+		t.Errorf("want service %s version 2 (last active 1), got service %s version %d (last active %d)", serviceID, id, version, lastActive)
+	}
+	svc := server.Service(serviceID)
+	if svc.Comment != "Managed by Terraform" {
+		t.Errorf("expected the comment to be updated, got %q", svc.Comment)
+	}
+	if len(svc.Versions) != 2 {
+		t.Fatalf("expected version 1 to be cloned, got %+v", svc.Versions)
+	}
+	if active := svc.Versions[0]; len(active.Domains) != 1 || len(active.Dictionaries) != 1 || len(active.Logging["kafka"]) != 1 {
+		t.Errorf("expected the nested resources of the active version to be kept, got %+v", active)
+	}
+	if draft := svc.Versions[1]; len(draft.Domains) != 0 || len(draft.Dictionaries) != 0 || len(draft.Logging["kafka"]) != 0 {
+		t.Errorf("expected the nested resources to be removed from the cloned version, got %+v", draft)
+	}
+
+	plan.Name = types.StringValue("unique")
+	diags = nil
+	if _, _, _, found, err := adoptService(ctx, plan, tfPlan, nestedResources, &diags, api); err != nil || found {
+		t.Errorf("expected no service to be adopted, got found %t (error: %v)", found, err)
+	}
+
+	server.AddService(t, "test")
+
+	plan.Name = types.StringValue("test")
+	diags = nil
+	if _, _, _, _, err := adoptService(ctx, plan, tfPlan, nestedResources, &diags, api); err == nil {
+		t.Error("expected error for an ambiguous service name")
+	}
+}
+
+// TestContractCheckActiveTraffic validates a destroy is refused while the
+// real-time stats report traffic above the threshold.
+func TestContractCheckActiveTraffic(t *testing.T) {
+	server, api, serviceID := mockapi.NewService(t)
+
+	var diags diag.Diagnostics
+	if err := checkActiveTraffic(context.Background(), api, serviceID, 0, &diags); err != nil {
+		t.Fatalf("want no traffic to permit a destroy, got: %s (%v)", err, diags)
+	}
+
+	server.SetTraffic(serviceID, 50)
+	if err := checkActiveTraffic(context.Background(), api, serviceID, 100, &diags); err != nil {
+		t.Errorf("want traffic below the threshold to permit a destroy, got: %s (%v)", err, diags)
+	}
+	if err := checkActiveTraffic(context.Background(), api, serviceID, 10, &diags); err == nil || !diags.HasError() {
+		t.Error("want traffic above the threshold to refuse a destroy")
+	}
+
+	diags = nil
+	server.FailNext(http.MethodGet, "/v1/channel/"+serviceID+"/ts/h", http.StatusInternalServerError)
+	if err := checkActiveTraffic(context.Background(), api, serviceID, 100, &diags); err == nil || !diags.HasError() {
+		t.Error("want unreadable stats to refuse a destroy")
+	}
+}
+
+// TestContractDeleteServiceDeleted validates destroying a service that was
+// deleted outside of Terraform (a 404 or a `deleted_at`) only warns, and no
+// further API calls are made.
+func TestContractDeleteServiceDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&Resource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	for name, tc := range map[string]struct {
+		deleted      bool
+		state        models.ServiceVCL
+		wantRequests []string
+	}{
+		"404": {
+			state:        models.ServiceVCL{ID: types.StringValue("unknown")},
+			wantRequests: []string{"DELETE /service/unknown"},
+		},
+		"404 with force_destroy": {
+			state:        models.ServiceVCL{ID: types.StringValue("unknown"), ForceDestroy: types.BoolValue(true)},
+			wantRequests: []string{"GET /service/unknown/details"},
+		},
+		"deleted_at with force_destroy": {
+			deleted:      true,
+			state:        models.ServiceVCL{ForceDestroy: types.BoolValue(true)},
+			wantRequests: []string{"GET /service/{id}/details"},
+		},
+		"deleted_at with reuse": {
+			deleted:      true,
+			state:        models.ServiceVCL{Reuse: types.BoolValue(true)},
+			wantRequests: []string{"GET /service/{id}/details"},
+		},
+		"deleted_at with prevent_destroy_if_active_traffic": {
+			deleted:      true,
+			state:        models.ServiceVCL{PreventDestroyIfActiveTraffic: types.BoolValue(true)},
+			wantRequests: []string{"GET /service/{id}/details"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server, api, serviceID := mockapi.NewService(t)
+			server.SetTraffic(serviceID, 1000)
+
+			if tc.deleted {
+				_, httpResp, err := api.Client.ServiceAPI.DeleteService(api.ClientCtx, serviceID).Execute()
+				if err != nil {
+					t.Fatalf("failed to delete mock service: %s", err)
+				}
+				httpResp.Body.Close()
+			}
+			if tc.state.ID.IsNull() {
+				tc.state.ID = types.StringValue(serviceID)
+			}
+
+			req := resource.DeleteRequest{State: tfsdk.State{Schema: schemaResp.Schema}}
+			resp := &resource.DeleteResponse{}
+			resp.Diagnostics.Append(req.State.Set(ctx, &tc.state)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			setup := len(server.Requests())
+
+			r := &Resource{client: api.Client, clientCtx: api.ClientCtx}
+			r.Delete(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			warnings := resp.Diagnostics.Warnings()
+			if len(warnings) != 1 || warnings[0].Summary() != helpers.WarningServiceDeleted {
+				t.Errorf("want a %q warning, got: %v", helpers.WarningServiceDeleted, warnings)
+			}
+
+			var got []string
+			for _, r := range server.Requests()[setup:] {
+				got = append(got, r.Method+" "+strings.ReplaceAll(r.Path, serviceID, "{id}"))
+			}
+			if strings.Join(got, ",") != strings.Join(tc.wantRequests, ",") {
+				t.Errorf("want requests %v, got: %v", tc.wantRequests, got)
+			}
+		})
+	}
+}
+
+// TestContractUpdateServiceDeleted validates updating a service that was
+// deleted outside of Terraform (a 404 or a `deleted_at`) reports an error.
+func TestContractUpdateServiceDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&Resource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	for name, deleted := range map[string]bool{"404": false, "deleted_at": true} {
+		t.Run(name, func(t *testing.T) {
+			server, api, serviceID := mockapi.NewService(t)
+
+			if deleted {
+				_, httpResp, err := api.Client.ServiceAPI.DeleteService(api.ClientCtx, serviceID).Execute()
+				if err != nil {
+					t.Fatalf("failed to delete mock service: %s", err)
+				}
+				httpResp.Body.Close()
+			} else {
+				serviceID = "unknown"
+			}
+
+			state := models.ServiceVCL{
+				ID:      types.StringValue(serviceID),
+				Name:    types.StringValue("test"),
+				Version: types.Int64Value(1),
+			}
+			plan := state
+			plan.Comment = types.StringValue("updated")
+
+			req := resource.UpdateRequest{
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+				State: tfsdk.State{Schema: schemaResp.Schema},
+			}
+			resp := &resource.UpdateResponse{}
+			resp.Diagnostics.Append(req.Plan.Set(ctx, &plan)...)
+			resp.Diagnostics.Append(req.State.Set(ctx, &state)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			setup := len(server.Requests())
+
+			r := &Resource{client: api.Client, clientCtx: api.ClientCtx}
+			r.Update(ctx, req, resp)
+
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != 1 || !strings.Contains(errs[0].Detail(), "was deleted outside of Terraform") {
+				t.Errorf("want a deleted service error, got: %v", resp.Diagnostics)
+			}
+			for _, r := range server.Requests()[setup:] {
+				if r.Method != http.MethodGet {
+					t.Errorf("want no changes to be made, got: %s %s", r.Method, r.Path)
+				}
+			}
+		})
+	}
+}
+
+// fakePrivateState is an in-memory private state.
+type fakePrivateState map[string][]byte
+
+func (p fakePrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p fakePrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+// TestContractCheckRemoteSnapshot validates an Update is aborted if the
+// service was changed after the plan was created.
+func TestContractCheckRemoteSnapshot(t *testing.T) {
+	_, api, serviceID := mockapi.NewService(t)
+	private := fakePrivateState{}
+
+	var diags diag.Diagnostics
+	readDetail := func() *fastly.ServiceDetail {
+		t.Helper()
+		clientResp, _, err := readServiceDetail(context.Background(), api, serviceID, &diags)
+		if err != nil {
+			t.Fatalf("unexpected error: %s (%v)", err, diags)
+		}
+		return clientResp
+	}
+
+	// A state without a snapshot (e.g. written by an earlier provider version).
+	if err := checkRemoteSnapshot(context.Background(), private, readDetail(), &diags); err != nil {
+		t.Errorf("want no snapshot to skip the check, got: %s (%v)", err, diags)
+	}
+
+	diags.Append(writeRemoteSnapshot(context.Background(), private, readDetail())...)
+	if err := checkRemoteSnapshot(context.Background(), private, readDetail(), &diags); err != nil {
+		t.Errorf("want an unchanged service to pass the check, got: %s (%v)", err, diags)
+	}
+
+	_, httpResp, err := api.Client.VersionAPI.CloneServiceVersion(api.ClientCtx, serviceID, 1).Execute()
+	if err != nil {
+		t.Fatalf("failed to clone mock service version: %s", err)
+	}
+	httpResp.Body.Close()
+
+	if err := checkRemoteSnapshot(context.Background(), private, readDetail(), &diags); err == nil || !diags.HasError() {
+		t.Error("want a new service version to fail the check")
+	}
+}
+
+// TestContractUpdateServiceProducts validates only the products whose planned
+// value differs from the state are enabled (or disabled), and the product
+// enablements are then read back into the state.
+func TestContractUpdateServiceProducts(t *testing.T) {
+	server, api, serviceID := mockapi.NewService(t)
+
+	state := &models.ServiceVCL{ID: types.StringValue(serviceID)}
+	for _, p := range serviceProducts(state) {
+		*p.enabled = types.BoolValue(false)
+	}
+	plan := *state
+	plan.ImageOptimizer = types.BoolValue(true)
+	plan.WebSockets = types.BoolValue(true)
+
+	var diags diag.Diagnostics
+	if err := updateServiceProducts(context.Background(), &plan, state, &diags, api); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+
+	var toggled []string
+	for _, req := range server.Requests() {
+		if req.Method == http.MethodPut || req.Method == http.MethodDelete {
+			toggled = append(toggled, req.Method+" "+req.Path)
+		}
+	}
+	want := []string{
+		"PUT /enabled-products/image_optimizer/services/" + serviceID,
+		"PUT /enabled-products/websockets/services/" + serviceID,
+	}
+	if len(toggled) != len(want) || toggled[0] != want[0] || toggled[1] != want[1] {
+		t.Errorf("want product requests %v, got: %v", want, toggled)
+	}
+
+	if err := readServiceProducts(context.Background(), state, &diags, api); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+	if !state.ImageOptimizer.ValueBool() || !state.WebSockets.ValueBool() || state.OriginInspector.ValueBool() {
+		t.Errorf("expected only image_optimizer and websockets to be enabled, got %+v", state)
+	}
+
+	plan.WebSockets = types.BoolValue(false)
+	if err := updateServiceProducts(context.Background(), &plan, state, &diags, api); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+	if products := server.Service(serviceID).Products; products[helpers.ProductWebSockets] || !products[helpers.ProductImageOptimizer] {
+		t.Errorf("expected websockets to be disabled, got %v", products)
+	}
+}
+
+// TestContractInspectDraftChanges validates the nested resource changes are
+// re-inspected against a reused draft version, so a change already applied to
+// the draft (before a prior Update failed) isn't applied again.
+func TestContractInspectDraftChanges(t *testing.T) {
+	server, api, serviceID := mockapi.NewService(t)
+	ctx := context.Background()
+
+	clientResp, httpResp, err := api.Client.VersionAPI.CloneServiceVersion(api.ClientCtx, serviceID, 1).Execute()
+	if err != nil {
+		t.Fatalf("failed to clone mock service version: %s", err)
+	}
+	httpResp.Body.Close()
+	draftVersion := clientResp.GetNumber()
+
+	// The prior Update added one of the two domains before it failed.
+	domainReq := api.Client.DomainAPI.CreateDomain(api.ClientCtx, serviceID, draftVersion)
+	domainReq.Name("a.example.com")
+	_, httpResp, err = domainReq.Execute()
+	if err != nil {
+		t.Fatalf("failed to create mock domain: %s", err)
+	}
+	httpResp.Body.Close()
+
+	var schemaResp resource.SchemaResponse
+	(&Resource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	state := models.ServiceVCL{
+		ID:      types.StringValue(serviceID),
+		Version: types.Int64Value(1),
+	}
+	plan := state
+	plan.Domains = map[string]models.Domain{
+		"a": {Name: types.StringValue("a.example.com"), CreatedAt: types.StringUnknown(), UpdatedAt: types.StringUnknown()},
+		"b": {Name: types.StringValue("b.example.com"), CreatedAt: types.StringUnknown(), UpdatedAt: types.StringUnknown()},
+	}
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	resp := &resource.UpdateResponse{}
+	resp.Diagnostics.Append(req.Plan.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	nestedResources := registry.NestedResources(helpers.ServiceTypeVCL)
+	if _, err := determineChangesInNestedResources(ctx, nestedResources, &req, resp); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, resp.Diagnostics)
+	}
+
+	serviceData := helpers.Service{ID: serviceID, Version: draftVersion}
+	if err := inspectDraftChanges(ctx, nestedResources, &req, resp, api, serviceData); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, resp.Diagnostics)
+	}
+	for _, nestedResource := range nestedResources {
+		if !nestedResource.HasChanges() {
+			continue
+		}
+		if err := nestedResource.Update(ctx, &req, resp, api, &serviceData); err != nil {
+			t.Fatalf("unexpected error: %s (%v)", err, resp.Diagnostics)
+		}
+	}
+
+	var created []string
+	for _, r := range server.Requests() {
+		if r.Method == http.MethodPost && r.Path == fmt.Sprintf("/service/%s/version/%d/domain", serviceID, draftVersion) {
+			created = append(created, r.Form.Get("name"))
+		}
+	}
+	if len(created) != 2 || created[1] != "b.example.com" {
+		t.Errorf("want only b.example.com to be created by the Update, got: %v", created)
+	}
+	if domains := server.Service(serviceID).Versions[draftVersion-1].Domains; len(domains) != 2 {
+		t.Errorf("want two domains in the draft version, got: %v", domains)
+	}
+}
+
+// TestContractUnsetServiceProducts validates a product that is unset in the
+// config isn't disabled, even if it was enabled outside of Terraform, and its
+// state is read from the API.
+func TestContractUnsetServiceProducts(t *testing.T) {
+	server, api, serviceID := mockapi.NewService(t)
+
+	var diags diag.Diagnostics
+	if err := helpers.EnableProduct(context.Background(), api, helpers.ProductWebSockets, serviceID, &diags); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+
+	plan := &models.ServiceVCL{ID: types.StringValue(serviceID)}
+	for _, p := range serviceProducts(plan) {
+		*p.enabled = types.BoolUnknown()
+	}
+	plan.ImageOptimizer = types.BoolValue(false)
+
+	if err := updateServiceProducts(context.Background(), plan, nil, &diags, api); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+	if err := setUnknownServiceProducts(context.Background(), plan, &diags, api); err != nil {
+		t.Fatalf("unexpected error: %s (%v)", err, diags)
+	}
+
+	if !server.Service(serviceID).Products[helpers.ProductWebSockets] {
+		t.Error("want the unset websockets product to remain enabled")
+	}
+	if !plan.WebSockets.ValueBool() || plan.BotManagement.IsUnknown() || plan.BotManagement.ValueBool() {
+		t.Errorf("want the unset products to be read from the API, got %+v", plan)
+	}
+	if !plan.ImageOptimizer.Equal(types.BoolValue(false)) {
+		t.Errorf("want the configured image_optimizer to be unchanged, got: %s", plan.ImageOptimizer)
+	}
+}
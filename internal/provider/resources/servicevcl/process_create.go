@@ -4,13 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/interfaces"
 	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
 )
 
@@ -18,12 +23,29 @@ import (
 // Config and planned state values should be read from the CreateRequest.
 // New state values set on the CreateResponse.
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	api := helpers.API{
-		Client:    r.client,
-		ClientCtx: r.clientCtx,
+	timeout, diags := readTimeout(ctx, req.Plan, "create")
+	resp.Diagnostics.Append(diags...)
+	activation, diags := readActivationOptions(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// NOTE: The activation window is checked before the service is created, so
+	// an apply outside of the window doesn't leave an unactivated service.
+	var activate types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("activate"), &activate)...)
+	if activate.ValueBool() {
+		err := helpers.CheckActivationWindow(activation.Window, activation.WindowOverride, time.Now(), &resp.Diagnostics)
+		if err != nil {
+			return
+		}
 	}
 
-	serviceID, serviceVersion, err := createService(ctx, req, resp, api)
+	api, reportAPITimings := r.newAPI(ctx, "Create", timeout)
+	defer reportAPITimings(&resp.Diagnostics)
+
+	serviceID, serviceVersion, lastActive, err := createService(ctx, req, resp, r.nestedResources, api)
 	if err != nil {
 		return
 	}
@@ -53,55 +75,96 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 
 	plan.ID = types.StringValue(serviceID)
 	plan.Version = types.Int64Value(int64(serviceVersion))
+	plan.ClonedVersion = types.Int64Value(int64(serviceVersion))
 	plan.LastActive = types.Int64Null()
+	if lastActive != 0 {
+		plan.LastActive = types.Int64Value(int64(lastActive))
+	}
 
 	// NOTE: There is no 'create service settings' API, only 'update'.
 	// So even though we're inside the CREATE function, we call updateSettings().
-	err = updateServiceSettings(ctx, plan, resp.Diagnostics, api)
+	err = updateServiceSettings(ctx, plan, &resp.Diagnostics, api)
+	if err != nil {
+		return
+	}
+
+	err = updateHTTP3(ctx, plan, nil, &resp.Diagnostics, api)
+	if err != nil {
+		return
+	}
+
+	err = updateServiceProducts(ctx, plan, nil, &resp.Diagnostics, api)
+	if err != nil {
+		return
+	}
+	// NOTE: An adopted service (see `adopt_existing`) might already have
+	// products enabled, so the unset products are read from the API.
+	err = setUnknownServiceProducts(ctx, plan, &resp.Diagnostics, api)
 	if err != nil {
 		return
 	}
 
 	if plan.Activate.ValueBool() {
-		clientReq := r.client.VersionAPI.ActivateServiceVersion(r.clientCtx, serviceID, serviceVersion)
-		_, httpResp, err := clientReq.Execute()
+		_, err = helpers.ActivateService(ctx, serviceID, serviceVersion, activation, api, &resp.Diagnostics)
 		if err != nil {
-			tflog.Trace(ctx, "Fastly VersionAPI.ActivateServiceVersion error", map[string]any{"http_resp": httpResp})
-			resp.Diagnostics.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to activate service version %d, got error: %s", 1, err))
 			return
 		}
-		defer httpResp.Body.Close()
 
 		// Only set LastActive to Version if we successfully activate the service.
 		plan.LastActive = plan.Version
+
+		if plan.LockActiveVersion.ValueBool() {
+			err = lockService(ctx, serviceID, serviceVersion, api, &resp.Diagnostics)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	setUnactivatedChanges(plan)
+
+	serviceDetail, err := readActiveVersionMetadata(ctx, plan, &resp.Diagnostics, api)
+	if err != nil {
+		return
 	}
+	resp.Diagnostics.Append(writeRemoteSnapshot(ctx, resp.Private, serviceDetail)...)
 
 	// Save the planned changes into Terraform state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 
-	tflog.Debug(ctx, "Create", map[string]any{"state": fmt.Sprintf("%#v", plan)})
+	tflog.Debug(ctx, "Create", map[string]any{"state": helpers.LogState(plan)})
 }
 
 func createService(
 	ctx context.Context,
 	req resource.CreateRequest,
 	resp *resource.CreateResponse,
+	nestedResources []interfaces.Resource,
 	api helpers.API,
-) (serviceID string, serviceVersion int32, err error) {
+) (serviceID string, serviceVersion, lastActive int32, err error) {
 	var plan *models.ServiceVCL
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
-		return "", 0, errors.New("failed to read Terraform plan")
+		return "", 0, 0, errors.New("failed to read Terraform plan")
 	}
 	if plan == nil {
 		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
 		msg := "nil pointer after plan population"
 		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, msg)
-		return "", 0, fmt.Errorf("%s: %s", helpers.ErrorTerraformPointer, msg)
+		return "", 0, 0, fmt.Errorf("%s: %s", helpers.ErrorTerraformPointer, msg)
 	}
 
+	if plan.AdoptExisting.ValueBool() {
+		serviceID, serviceVersion, lastActive, found, err := adoptService(ctx, plan, req.Plan, nestedResources, &resp.Diagnostics, api)
+		if err != nil || found {
+			return serviceID, serviceVersion, lastActive, err
+		}
+	}
+
+	// NOTE: The comment is always sent, even if it's an empty string.
+	// This is how a user opts out of the default comment.
 	clientReq := api.Client.ServiceAPI.CreateService(api.ClientCtx)
 	clientReq.Comment(plan.Comment.ValueString())
 	clientReq.Name(plan.Name.ValueString())
@@ -109,32 +172,173 @@ func createService(
 
 	clientResp, httpResp, err := clientReq.Execute()
 	if err != nil {
-		tflog.Trace(ctx, "Fastly ServiceAPI.CreateService error", map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to create service, got error: %s", err))
-		return "", 0, err
+		tflog.Trace(ctx, "Fastly ServiceAPI.CreateService error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to create service")
+		return "", 0, 0, err
 	}
 	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode != http.StatusOK {
-		tflog.Trace(ctx, helpers.ErrorAPI, map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPI, fmt.Sprintf("Unsuccessful status code: %s", httpResp.Status))
-		return "", 0, fmt.Errorf("failed to create service: %s", httpResp.Status)
+	if err := helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics); err != nil {
+		return "", 0, 0, fmt.Errorf("failed to create service: %s", httpResp.Status)
 	}
 
 	id, ok := clientResp.GetIDOk()
 	if !ok {
-		tflog.Trace(ctx, helpers.ErrorAPI, map[string]any{"http_resp": httpResp})
+		tflog.Trace(ctx, helpers.ErrorAPI, map[string]any{"http_resp": helpers.LogResponse(httpResp)})
 		resp.Diagnostics.AddError(helpers.ErrorAPI, "No Service ID was returned")
-		return "", 0, errors.New("failed to create service: no Service ID returned")
+		return "", 0, 0, errors.New("failed to create service: no Service ID returned")
 	}
 
 	versions, ok := clientResp.GetVersionsOk()
 	if !ok {
-		tflog.Trace(ctx, helpers.ErrorAPI, map[string]any{"http_resp": httpResp})
+		tflog.Trace(ctx, helpers.ErrorAPI, map[string]any{"http_resp": helpers.LogResponse(httpResp)})
 		resp.Diagnostics.AddError(helpers.ErrorAPI, "No Service versions returned")
-		return "", 0, errors.New("failed to create service: no Service versions returned")
+		return "", 0, 0, errors.New("failed to create service: no Service versions returned")
 	}
 	version := versions[0].GetNumber()
 
-	return *id, version, nil
+	return *id, version, 0, nil
+}
+
+// adoptService looks for an existing service with the planned name, and if one
+// (and only one) is found, it clones the active (or latest) version into a new draft version
+// that the plan is then applied to (instead of creating a duplicate service).
+//
+// NOTE: The nested resources (e.g. domains, dictionaries, logging endpoints) of
+// the draft version are deleted, as the nested resources create every planned
+// entity. Any other configuration of the adopted service is kept, and
+// attributes that only differ from the remote configuration (e.g. `websockets`)
+// are reconciled by the next plan.
+func adoptService(
+	ctx context.Context,
+	plan *models.ServiceVCL,
+	tfPlan tfsdk.Plan,
+	nestedResources []interfaces.Resource,
+	diags *diag.Diagnostics,
+	api helpers.API,
+) (serviceID string, serviceVersion, lastActive int32, found bool, err error) {
+	name := plan.Name.ValueString()
+
+	ids, err := servicesNamed(ctx, api, name, "", diags)
+	if err != nil {
+		return "", 0, 0, false, err
+	}
+	switch len(ids) {
+	case 0:
+		tflog.Debug(ctx, "No existing service to adopt, creating a new service", map[string]any{"name": name})
+		return "", 0, 0, false, nil
+	case 1:
+		serviceID = ids[0]
+	default:
+		diags.AddError(helpers.ErrorUser, fmt.Sprintf("Unable to adopt an existing service, as more than one service is named '%s' (%s). Import the service to adopt instead", name, strings.Join(ids, ", ")))
+		return "", 0, 0, false, fmt.Errorf("failed to adopt service: %d services named '%s'", len(ids), name)
+	}
+
+	clientResp, httpResp, err := api.Client.ServiceAPI.GetServiceDetail(api.ClientCtx, serviceID).Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly ServiceAPI.GetServiceDetail error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to retrieve service details")
+		return "", 0, 0, false, err
+	}
+	defer httpResp.Body.Close()
+
+	if t := clientResp.GetType(); t != "vcl" {
+		diags.AddError(helpers.ErrorUser, fmt.Sprintf("The existing service '%s' (%s) is a '%s' service, only a VCL service can be adopted", name, serviceID, t))
+		return "", 0, 0, false, fmt.Errorf("failed to adopt service: unexpected service type '%s'", t)
+	}
+
+	versions := clientResp.GetVersions()
+	if len(versions) == 0 {
+		diags.AddError(helpers.ErrorAPI, fmt.Sprintf("No versions were returned for the existing service '%s'", serviceID))
+		return "", 0, 0, false, errors.New("failed to adopt service: no Service versions returned")
+	}
+	cloneFrom := versions[len(versions)-1].GetNumber()
+	for _, v := range versions {
+		if v.GetActive() {
+			cloneFrom = v.GetNumber()
+			lastActive = cloneFrom
+		}
+	}
+
+	// NOTE: UpdateService doesn't take a version because its attributes are versionless.
+	if !plan.Comment.Equal(types.StringValue(clientResp.GetComment())) {
+		updateReq := api.Client.ServiceAPI.UpdateService(api.ClientCtx, serviceID)
+		updateReq.Comment(plan.Comment.ValueString())
+		_, httpResp, err := updateReq.Execute()
+		if err != nil {
+			tflog.Trace(ctx, "Fastly ServiceAPI.UpdateService error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+			helpers.APIError(httpResp, err, diags, "Unable to update service")
+			return "", 0, 0, false, err
+		}
+		defer httpResp.Body.Close()
+	}
+
+	version, httpResp, err := api.Client.VersionAPI.CloneServiceVersion(api.ClientCtx, serviceID, cloneFrom).Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly VersionAPI.CloneServiceVersion error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to clone service version")
+		return "", 0, 0, false, err
+	}
+	defer httpResp.Body.Close()
+	serviceVersion = version.GetNumber()
+
+	serviceData := helpers.Service{ID: serviceID, Version: serviceVersion}
+	if err := clearNestedResources(ctx, nestedResources, tfPlan, api, serviceData, diags); err != nil {
+		return "", 0, 0, false, err
+	}
+
+	diags.AddWarning(
+		"Service Adopted",
+		fmt.Sprintf("The existing service '%s' (%s) was adopted instead of creating a new service. Version %d was cloned into version %d, which the configuration was applied to.", name, serviceID, cloneFrom, serviceVersion),
+	)
+	tflog.Debug(ctx, "Adopted existing service", map[string]any{"id": serviceID, "cloned_from": cloneFrom, "version": serviceVersion})
+
+	return serviceID, serviceVersion, lastActive, true, nil
+}
+
+// clearNestedResources deletes the entities of every nested resource from the
+// service version, by reading the version's entities as the prior state of
+// the nested resources and then applying an empty plan.
+//
+// The tfPlan is only used for its schema.
+func clearNestedResources(
+	ctx context.Context,
+	nestedResources []interfaces.Resource,
+	tfPlan tfsdk.Plan,
+	api helpers.API,
+	serviceData helpers.Service,
+	diags *diag.Diagnostics,
+) error {
+	empty := tfsdk.State{Schema: tfPlan.Schema}
+	diags.Append(empty.Set(ctx, &models.ServiceVCL{})...)
+	if diags.HasError() {
+		return errors.New("failed to build an empty state")
+	}
+
+	readReq := resource.ReadRequest{State: empty}
+	readResp := resource.ReadResponse{State: empty}
+	err := readNestedResources(ctx, nestedResources, true, &readReq, &readResp, api, serviceData)
+	diags.Append(readResp.Diagnostics...)
+	if err != nil {
+		return err
+	}
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: empty.Schema, Raw: empty.Raw},
+		State: readReq.State,
+	}
+	resp := resource.UpdateResponse{State: empty}
+	_, err = determineChangesInNestedResources(ctx, nestedResources, &req, &resp)
+	if err == nil {
+		for _, nestedResource := range nestedResources {
+			if !nestedResource.HasChanges() {
+				continue
+			}
+			if err = nestedResource.Update(ctx, &req, &resp, api, &serviceData); err != nil {
+				break
+			}
+		}
+	}
+	diags.Append(resp.Diagnostics...)
+	return err
 }
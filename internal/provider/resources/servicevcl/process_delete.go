@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -30,32 +31,53 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
-	if state.ForceDestroy.ValueBool() || state.Reuse.ValueBool() {
-		clientReq := r.client.ServiceAPI.GetServiceDetail(r.clientCtx, state.ID.ValueString())
-		clientResp, httpResp, err := clientReq.Execute()
+	// The domains might be added to another service in the same apply, which
+	// waits for this service to release them.
+	if !state.Reuse.ValueBool() {
+		for _, domainData := range state.Domains {
+			helpers.ExpectDomainRelease(domainData.Name.ValueString())
+		}
+	}
+
+	timeout, diags := readTimeout(ctx, req.State, "delete")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, reportAPITimings := r.newAPI(ctx, "Delete", timeout)
+	defer reportAPITimings(&resp.Diagnostics)
+
+	if state.ForceDestroy.ValueBool() || state.Reuse.ValueBool() || state.PreventDestroyIfActiveTraffic.ValueBool() {
+		serviceDetail, deleted, err := readServiceDetail(ctx, api, state.ID.ValueString(), &resp.Diagnostics)
 		if err != nil {
-			tflog.Trace(ctx, "Fastly ServiceAPI.GetServiceDetail error", map[string]any{"http_resp": httpResp})
-			resp.Diagnostics.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to retrieve service details, got error: %s", err))
 			return
 		}
-		defer httpResp.Body.Close()
 
 		// Service was deleted outside of Terraform.
-		if deletedAt, _ := clientResp.GetDeletedAtOk(); deletedAt != nil {
+		if deleted {
+			addServiceDeletedWarning(ctx, state.ID.ValueString(), &resp.Diagnostics)
 			return
 		}
 
+		if state.PreventDestroyIfActiveTraffic.ValueBool() {
+			err := checkActiveTraffic(ctx, api, state.ID.ValueString(), state.ActiveTrafficThreshold.ValueInt64(), &resp.Diagnostics)
+			if err != nil {
+				return
+			}
+		}
+
 		var activeVersion int32
-		if clientResp.GetActiveVersion().Number != nil {
-			activeVersion = *clientResp.GetActiveVersion().Number
+		if serviceDetail.GetActiveVersion().Number != nil {
+			activeVersion = *serviceDetail.GetActiveVersion().Number
 		}
 
-		if activeVersion != 0 {
-			clientReq := r.client.VersionAPI.DeactivateServiceVersion(r.clientCtx, state.ID.ValueString(), activeVersion)
+		if activeVersion != 0 && (state.ForceDestroy.ValueBool() || state.Reuse.ValueBool()) {
+			clientReq := api.Client.VersionAPI.DeactivateServiceVersion(api.ClientCtx, state.ID.ValueString(), activeVersion)
 			_, httpResp, err := clientReq.Execute()
 			if err != nil {
-				tflog.Trace(ctx, "Fastly VersionAPI.DeactivateServiceVersion error", map[string]any{"http_resp": httpResp})
-				resp.Diagnostics.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to deactivate service version %d, got error: %s", activeVersion, err))
+				tflog.Trace(ctx, "Fastly VersionAPI.DeactivateServiceVersion error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+				helpers.APIError(httpResp, err, &resp.Diagnostics, fmt.Sprintf("Unable to deactivate service version %d", activeVersion))
 				return
 			}
 			defer httpResp.Body.Close()
@@ -63,15 +85,76 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	}
 
 	if !state.Reuse.ValueBool() {
-		clientReq := r.client.ServiceAPI.DeleteService(r.clientCtx, state.ID.ValueString())
+		clientReq := api.Client.ServiceAPI.DeleteService(api.ClientCtx, state.ID.ValueString())
 		_, httpResp, err := clientReq.Execute()
 		if err != nil {
-			tflog.Trace(ctx, "Fastly ServiceAPI.DeleteService error", map[string]any{"http_resp": httpResp})
-			resp.Diagnostics.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to delete service, got error: %s", err))
+			// The service was deleted outside of Terraform, so there's nothing to do.
+			if helpers.IsNotFound(httpResp) {
+				addServiceDeletedWarning(ctx, state.ID.ValueString(), &resp.Diagnostics)
+				return
+			}
+			tflog.Trace(ctx, "Fastly ServiceAPI.DeleteService error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+			helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to delete service")
 			return
 		}
 		defer httpResp.Body.Close()
+
+		// The domains can now be created by another service.
+		for _, domainData := range state.Domains {
+			helpers.ReleaseDomain(domainData.Name.ValueString())
+		}
+	}
+
+	tflog.Debug(ctx, "Delete", map[string]any{"state": helpers.LogState(state)})
+}
+
+// checkActiveTraffic returns an error if the service is receiving more than
+// threshold requests per second, averaged over the real-time stats for the
+// last 120 seconds.
+//
+// NOTE: If the stats can't be read, then the destroy is also refused, as the
+// protection would otherwise be silently bypassed.
+func checkActiveTraffic(ctx context.Context, api helpers.API, serviceID string, threshold int64, diags *diag.Diagnostics) error {
+	clientReq := api.Client.RealtimeAPI.GetStatsLast120Seconds(api.ClientCtx, serviceID)
+	clientResp, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly RealtimeAPI.GetStatsLast120Seconds error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to read the real-time stats to check the service traffic (set `prevent_destroy_if_active_traffic` to `false` to skip the check)")
+		return err
 	}
+	defer httpResp.Body.Close()
 
-	tflog.Debug(ctx, "Delete", map[string]any{"state": fmt.Sprintf("%#v", state)})
+	var requests int64
+	entries := clientResp.GetData()
+	for _, entry := range entries {
+		aggregated := entry.GetAggregated()
+		requests += int64(aggregated.GetRequests())
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	rps := requests / int64(len(entries))
+	tflog.Debug(ctx, "Active traffic", map[string]any{"id": serviceID, "requests_per_second": rps, "threshold": threshold})
+	if rps > threshold {
+		err := fmt.Errorf("service is receiving %d requests per second", rps)
+		diags.AddError(
+			helpers.ErrorUser,
+			fmt.Sprintf("The service %s is receiving %d requests per second (above the `active_traffic_threshold` of %d), so it hasn't been destroyed. To destroy the service anyway, set `prevent_destroy_if_active_traffic` to `false` and apply before destroying.", serviceID, rps, threshold),
+		)
+		return err
+	}
+	return nil
+}
+
+// addServiceDeletedWarning informs the user that a service being destroyed had
+// already been deleted outside of Terraform.
+//
+// NOTE: The framework removes the resource from the state as no error is set.
+func addServiceDeletedWarning(ctx context.Context, serviceID string, diags *diag.Diagnostics) {
+	tflog.Warn(ctx, "Fastly service deleted outside of Terraform", map[string]any{"id": serviceID})
+	diags.AddWarning(
+		helpers.WarningServiceDeleted,
+		fmt.Sprintf("The service %s was already deleted outside of Terraform, so it has been removed from the state.", serviceID),
+	)
 }
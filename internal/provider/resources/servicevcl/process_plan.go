@@ -0,0 +1,184 @@
+package servicevcl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/interfaces"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// servicesPerPage is the page size used when listing services.
+const servicesPerPage = 100
+
+// ModifyPlan is called when the provider has an opportunity to modify the plan.
+//
+// The API token is checked for the required scope, so a token that can't
+// manage the service fails the plan rather than the apply.
+//
+// For an update, a warning summarizes the nested resource changes and whether
+// they'll result in a new service version being cloned and activated.
+//
+// NOTE: If the provider's `warn_duplicate_service_names` attribute is enabled,
+// then a warning is emitted for a new (or renamed) service if another service
+// already has the same name (unless the service is going to be adopted with
+// `adopt_existing`).
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The resource is being destroyed, or the provider isn't configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var planName, stateName, serviceID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &planName)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &stateName)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &serviceID)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, reportAPITimings := r.newAPI(ctx, "ModifyPlan", 0)
+	defer reportAPITimings(&resp.Diagnostics)
+
+	r.token.CheckScope(ctx, api, "fastly_service_vcl", serviceID.ValueString(), helpers.PlanHasChanges(req.Plan.Raw, req.State.Raw), &resp.Diagnostics, helpers.ScopeGlobal)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		summarizeChanges(ctx, r.nestedResources, req, resp)
+	}
+
+	// A service with the same name is expected when it's going to be adopted.
+	var adoptExisting types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("adopt_existing"), &adoptExisting)...)
+	if req.State.Raw.IsNull() && adoptExisting.ValueBool() {
+		return
+	}
+
+	if !r.warnDuplicateNames || planName.IsUnknown() || planName.IsNull() || planName.Equal(stateName) {
+		return
+	}
+
+	duplicateIDs, err := servicesNamed(ctx, api, planName.ValueString(), serviceID.ValueString(), &resp.Diagnostics)
+	if err != nil || len(duplicateIDs) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("name"),
+		"Duplicate Service Name",
+		fmt.Sprintf("Another service already uses the name '%s' (%s). The Fastly API allows duplicate service names, but consider using a unique name to avoid confusion.", planName.ValueString(), strings.Join(duplicateIDs, ", ")),
+	)
+}
+
+// summarizeChanges emits a warning summarizing the planned changes to the
+// nested resources (e.g. `domains: 2 added, 1 modified`), so the blast radius
+// of an apply can be reviewed from the plan output.
+//
+// NOTE: The framework has no 'info' diagnostic severity, so a warning is used.
+// No summary is emitted if the plan doesn't require a new service version.
+func summarizeChanges(ctx context.Context, nestedResources []interfaces.Resource, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state *models.ServiceVCL
+	// NOTE: The plan can't be read into the model if a whole nested attribute is
+	// unknown (e.g. it's derived from another resource), so no summary is shown.
+	if resp.Plan.Get(ctx, &plan).HasError() || req.State.Get(ctx, &state).HasError() || plan == nil || state == nil {
+		return
+	}
+
+	// NOTE: InspectChanges can modify the plan (e.g. a domain whose key has only
+	// been renamed), so it's given a copy rather than the planned state.
+	updateReq := resource.UpdateRequest{
+		Config: req.Config,
+		Plan:   tfsdk.Plan{Schema: resp.Plan.Schema, Raw: resp.Plan.Raw.Copy()},
+		State:  req.State,
+	}
+
+	var changes []string
+	for _, nestedResource := range nestedResources {
+		_, err := nestedResource.InspectChanges(ctx, &updateReq, &resource.UpdateResponse{}, helpers.API{}, &helpers.Service{})
+		if err != nil {
+			tflog.Trace(ctx, "Provider error", map[string]any{"error": err})
+			return
+		}
+		if nestedResource.HasChanges() {
+			added, deleted, modified := nestedResource.ChangeCounts()
+			changes = append(changes, fmt.Sprintf("%s: %s", nestedResource.Attribute(), changeCounts(added, deleted, modified)))
+		}
+	}
+	if serviceSettingsChanged(plan, state) {
+		changes = append(changes, "settings: modified")
+	}
+	if len(changes) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddWarning("Service Change Summary", changeSummary(changes, plan.Activate.ValueBool(), state.Version.ValueInt64()))
+}
+
+// changeCounts describes the number of added, deleted and modified entities
+// (omitting any that are zero), e.g. `2 added, 1 modified`.
+func changeCounts(added, deleted, modified int) string {
+	var counts []string
+	for _, c := range []struct {
+		n    int
+		verb string
+	}{{added, "added"}, {deleted, "deleted"}, {modified, "modified"}} {
+		if c.n > 0 {
+			counts = append(counts, fmt.Sprintf("%d %s", c.n, c.verb))
+		}
+	}
+	return strings.Join(counts, ", ")
+}
+
+// changeSummary returns the detail of the change summary warning.
+//
+// NOTE: When `activate` is false, a draft version that hasn't been activated is
+// modified in place rather than cloned (see Update).
+func changeSummary(changes []string, activate bool, version int64) string {
+	summary := strings.Join(changes, "; ") + "."
+	if activate {
+		return summary + fmt.Sprintf(" A new service version will be cloned from version %d and activated.", version)
+	}
+	return summary + fmt.Sprintf(" The changes will be applied to a draft service version (cloned from version %d if it's locked or active), which won't be activated as `activate` is false.", version)
+}
+
+// servicesNamed returns the IDs of the services with the given name (excluding
+// the service with the given ID).
+func servicesNamed(ctx context.Context, api helpers.API, name, excludeID string, diags *diag.Diagnostics) ([]string, error) {
+	var ids []string
+
+	for page := int32(1); ; page++ {
+		clientReq := api.Client.ServiceAPI.ListServices(api.ClientCtx)
+		clientReq = *clientReq.Page(page).PerPage(servicesPerPage)
+
+		clientResp, httpResp, err := clientReq.Execute()
+		if err != nil {
+			tflog.Trace(ctx, "Fastly ServiceAPI.ListServices error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+			helpers.APIError(httpResp, err, diags, "Unable to list services")
+			return nil, err
+		}
+		httpResp.Body.Close()
+
+		for _, service := range clientResp {
+			if service.GetName() == name && service.GetID() != excludeID {
+				ids = append(ids, service.GetID())
+			}
+		}
+
+		if len(clientResp) < servicesPerPage {
+			return ids, nil
+		}
+	}
+}
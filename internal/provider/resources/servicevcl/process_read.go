@@ -4,14 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 
 	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/interfaces"
 	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
 )
 
@@ -33,11 +35,21 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	clientReq := r.client.ServiceAPI.GetServiceDetail(r.clientCtx, state.ID.ValueString())
+	api, reportAPITimings := r.newAPI(ctx, "Read", 0)
+	defer reportAPITimings(&resp.Diagnostics)
+
+	clientReq := api.Client.ServiceAPI.GetServiceDetail(api.ClientCtx, state.ID.ValueString())
 	clientResp, httpResp, err := clientReq.Execute()
 	if err != nil {
-		tflog.Trace(ctx, "Fastly ServiceAPI.GetServiceDetail error", map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to retrieve service details, got error: %s", err))
+		// The service doesn't exist (e.g. it was purged after being deleted
+		// outside of Terraform), so we remove it from the state.
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, "Fastly service not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		tflog.Trace(ctx, "Fastly ServiceAPI.GetServiceDetail error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to retrieve service details")
 		return
 	}
 	defer httpResp.Body.Close()
@@ -45,7 +57,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	// Check if the service has been deleted outside of Terraform.
 	// And if so we'll just return.
 	if t, ok := clientResp.GetDeletedAtOk(); ok && t != nil {
-		tflog.Trace(ctx, "Fastly ServiceAPI.GetDeletedAtOk", map[string]any{"deleted_at": t, "state": state})
+		tflog.Trace(ctx, "Fastly ServiceAPI.GetDeletedAtOk", map[string]any{"deleted_at": t, "state": helpers.LogState(state)})
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -54,14 +66,14 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	serviceType := clientResp.GetType()
 	vclServiceType := helpers.ServiceTypeVCL.String()
 	if serviceType != vclServiceType {
-		tflog.Trace(ctx, "Fastly service type error", map[string]any{"http_resp": httpResp, "type": serviceType})
+		tflog.Trace(ctx, "Fastly service type error", map[string]any{"http_resp": helpers.LogResponse(httpResp), "type": serviceType})
 		resp.Diagnostics.AddError(helpers.ErrorUser, fmt.Sprintf("Expected service type %s, got: %s", vclServiceType, serviceType))
 		return
 	}
 
 	remoteServiceVersion, err := readServiceVersion(state, clientResp)
 	if err != nil {
-		tflog.Trace(ctx, "Fastly service version identification error", map[string]any{"state": state, "service_details": clientResp, "error": err})
+		tflog.Trace(ctx, "Fastly service version identification error", map[string]any{"state": helpers.LogState(state), "service_details": clientResp, "error": err})
 		resp.Diagnostics.AddError(helpers.ErrorUnknown, err.Error())
 		return
 	}
@@ -80,11 +92,6 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		state.ForceRefresh = types.BoolValue(true)
 	}
 
-	api := helpers.API{
-		Client:    r.client,
-		ClientCtx: r.clientCtx,
-	}
-
 	// IMPORTANT: nestedResources are expected to mutate the `req` plan data.
 	//
 	// We really should modify the `state` variable instead.
@@ -93,14 +100,13 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	// This is because the `state` variable type can change based on the resource.
 	// e.g. `models.ServiceVCL` or `models.ServiceCompute`.
 	// See `readSettings()` for an example of directly modifying `state`.
-	for _, nestedResource := range r.nestedResources {
-		serviceData := helpers.Service{
-			ID:      clientResp.GetID(),
-			Version: int32(remoteServiceVersion),
-		}
-		if err := nestedResource.Read(ctx, &req, resp, api, &serviceData); err != nil {
-			return
-		}
+	serviceData := helpers.Service{
+		ID:      clientResp.GetID(),
+		Version: int32(remoteServiceVersion),
+	}
+	refreshAll := state.Imported.ValueBool() || state.ForceRefresh.ValueBool()
+	if err := readNestedResources(ctx, r.nestedResources, refreshAll, &req, resp, api, serviceData); err != nil {
+		return
 	}
 
 	// Sync the Terraform `state` data.
@@ -111,12 +117,29 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	}
 
 	setServiceState(state, clientResp, remoteServiceVersion)
+	setServiceMetadata(state, clientResp)
+	setActiveVersionMetadata(ctx, state, clientResp, api)
+
+	resp.Diagnostics.Append(writeRemoteSnapshot(ctx, resp.Private, clientResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	err = readServiceSettings(ctx, remoteServiceVersion, state, resp, api)
 	if err != nil {
 		return
 	}
 
+	err = readHTTP3(ctx, remoteServiceVersion, state, &resp.Diagnostics, api)
+	if err != nil {
+		return
+	}
+
+	err = readServiceProducts(ctx, state, &resp.Diagnostics, api)
+	if err != nil {
+		return
+	}
+
 	// To ensure nested resources don't continue to call the Fastly API to
 	// refresh the internal Terraform state, we set `imported`/`force_refresh`
 	// back to false.
@@ -134,7 +157,70 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	// Save the final `state` data back into Terraform state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
-	tflog.Debug(ctx, "Read", map[string]any{"state": fmt.Sprintf("%#v", state)})
+	tflog.Debug(ctx, "Read", map[string]any{"state": helpers.LogState(state)})
+
+	if t, ok := r.client.GetConfig().HTTPClient.Transport.(*helpers.ConditionalTransport); ok {
+		hits, misses := t.Stats()
+		tflog.Debug(ctx, "Conditional requests", map[string]any{"hits": hits, "misses": misses})
+	}
+}
+
+// readNestedResources calls Read() on all nested resources concurrently.
+//
+// Unless refreshAll is true (e.g. when importing or when the service version
+// has drifted) we only call Read() for nested resources that have an attribute
+// set in the prior state. This avoids calling the Fastly API for every nested
+// resource type when the user's config only defines a few of them.
+//
+// Each nested resource is given its own copy of the request state and its own
+// diagnostics. Once all nested resources have been read, the diagnostics are
+// appended (in nested resource order) and the state copies are merged back
+// into the `req` state.
+func readNestedResources(
+	ctx context.Context,
+	nestedResources []interfaces.Resource,
+	refreshAll bool,
+	req *resource.ReadRequest,
+	resp *resource.ReadResponse,
+	api helpers.API,
+	serviceData helpers.Service,
+) error {
+	nestedReqs := make([]resource.ReadRequest, len(nestedResources))
+	nestedResps := make([]resource.ReadResponse, len(nestedResources))
+
+	err := helpers.ForEach(len(nestedResources), helpers.MaxConcurrency, func(i int) error {
+		nestedReqs[i] = *req
+		nestedResps[i] = resource.ReadResponse{
+			State:   resp.State,
+			Private: resp.Private,
+		}
+		attr := nestedResources[i].Attribute()
+		if !refreshAll && !helpers.HasStateAttribute(req.State.Raw, attr) {
+			tflog.Trace(ctx, "Skipping nested resource refresh", map[string]any{"attribute": attr})
+			return nil
+		}
+		nestedServiceData := serviceData
+		return nestedResources[i].Read(ctx, &nestedReqs[i], &nestedResps[i], api, &nestedServiceData)
+	})
+
+	states := make([]tftypes.Value, 0, len(nestedReqs))
+	for i := range nestedResources {
+		resp.Diagnostics.Append(nestedResps[i].Diagnostics...)
+		states = append(states, nestedReqs[i].State.Raw)
+	}
+	if err != nil {
+		return err
+	}
+
+	merged, err := helpers.MergeState(req.State.Raw, states...)
+	if err != nil {
+		tflog.Trace(ctx, "Provider error", map[string]any{"error": err})
+		resp.Diagnostics.AddError(helpers.ErrorProvider, fmt.Sprintf("Unable to merge nested resource state, got error: %s", err))
+		return err
+	}
+	req.State.Raw = merged
+
+	return nil
 }
 
 // readServiceVersion returns the service version.
@@ -227,6 +313,14 @@ func setServiceState(state *models.ServiceVCL, clientResp *fastly.ServiceDetail,
 	state.Name = types.StringValue(clientResp.GetName())
 	state.Version = types.Int64Value(remoteServiceVersion)
 
+	// NOTE: The active version is read from the API (rather than `last_active`)
+	// so a version activated outside of Terraform is reflected.
+	var activeVersion int64
+	if v := clientResp.GetActiveVersion(); v.Number != nil {
+		activeVersion = int64(*v.Number)
+	}
+	state.HasUnactivatedChanges = types.BoolValue(activeVersion != remoteServiceVersion)
+
 	// We set `last_active` to align with `version` only if `activate=true`.
 	// We only expect `version` to drift from `last_active` if `activate=false`.
 	if state.Activate.ValueBool() {
@@ -234,6 +328,83 @@ func setServiceState(state *models.ServiceVCL, clientResp *fastly.ServiceDetail,
 	}
 }
 
+// setServiceMetadata sets the computed attributes describing the service (as
+// opposed to a service version) from the service details.
+func setServiceMetadata(data *models.ServiceVCL, clientResp *fastly.ServiceDetail) {
+	data.CreatedAt = helpers.Timestamp(clientResp.CreatedAt)
+	data.CustomerID = types.StringNull()
+	if id := clientResp.GetCustomerID(); id != "" {
+		data.CustomerID = types.StringValue(id)
+	}
+	data.UpdatedAt = helpers.Timestamp(clientResp.UpdatedAt)
+}
+
+// readActiveVersionMetadata reads the service details so the computed
+// attributes describing the service and its active version can be set.
+//
+// The service details are returned so the caller can reuse them.
+func readActiveVersionMetadata(ctx context.Context, data *models.ServiceVCL, diags *diag.Diagnostics, api helpers.API) (*fastly.ServiceDetail, error) {
+	clientResp, httpResp, err := api.Client.ServiceAPI.GetServiceDetail(api.ClientCtx, data.ID.ValueString()).Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly ServiceAPI.GetServiceDetail error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to retrieve service details")
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return nil, err
+	}
+
+	setServiceMetadata(data, clientResp)
+	setActiveVersionMetadata(ctx, data, clientResp, api)
+	return clientResp, nil
+}
+
+// setActiveVersionMetadata sets the computed attributes describing the active
+// service version from the version list in the service details.
+//
+// NOTE: The version list doesn't include who activated the version, so the
+// user is looked up in the account event log. This is best-effort, as the API
+// token might not be permitted to read the event log, and is null on failure.
+func setActiveVersionMetadata(ctx context.Context, data *models.ServiceVCL, clientResp *fastly.ServiceDetail, api helpers.API) {
+	data.ActiveVersionActivatedBy = types.StringNull()
+	data.ActiveVersionCreatedAt = types.StringNull()
+	data.ActiveVersionUpdatedAt = types.StringNull()
+
+	for _, v := range clientResp.GetVersions() {
+		if !v.GetActive() {
+			continue
+		}
+		data.ActiveVersionCreatedAt = helpers.Timestamp(v.CreatedAt)
+		data.ActiveVersionUpdatedAt = helpers.Timestamp(v.UpdatedAt)
+		data.ActiveVersionActivatedBy = activatedBy(ctx, clientResp.GetID(), api)
+		return
+	}
+}
+
+// activatedBy returns the ID of the user who last activated a service version.
+func activatedBy(ctx context.Context, serviceID string, api helpers.API) types.String {
+	clientReq := api.Client.EventsAPI.ListEvents(api.ClientCtx)
+	clientReq.FilterServiceID(serviceID)
+	clientReq.FilterEventType("version.activate")
+	clientReq.Sort("-created_at")
+	clientReq.PageSize(1)
+
+	clientResp, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read the service activation events", map[string]any{"http_resp": helpers.LogResponse(httpResp), "error": err.Error()})
+		return types.StringNull()
+	}
+	defer httpResp.Body.Close()
+
+	for _, event := range clientResp.GetData() {
+		if attrs := event.GetAttributes(); attrs.GetUserID() != "" {
+			return types.StringValue(attrs.GetUserID())
+		}
+	}
+	return types.StringNull()
+}
+
 func readServiceSettings(ctx context.Context, serviceVersion int64, state *models.ServiceVCL, resp *resource.ReadResponse, api helpers.API) error {
 	serviceID := state.ID.ValueString()
 	clientReq := api.Client.SettingsAPI.GetServiceSettings(api.ClientCtx, serviceID, int32(serviceVersion))
@@ -241,39 +412,19 @@ func readServiceSettings(ctx context.Context, serviceVersion int64, state *model
 
 	clientResp, httpResp, err := clientReq.Execute()
 	if err != nil {
-		tflog.Trace(ctx, "Fastly SettingsAPI.GetServiceSettings error", map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to read service settings, got error: %s", err))
+		tflog.Trace(ctx, "Fastly SettingsAPI.GetServiceSettings error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to read service settings")
 		return readErr
 	}
 	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode != http.StatusOK {
-		tflog.Trace(ctx, helpers.ErrorAPI, map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPI, fmt.Sprintf("Unsuccessful status code: %s", httpResp.Status))
+	if err := helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics); err != nil {
 		return readErr
 	}
 
-	if ptr, ok := clientResp.GetGeneralDefaultHostOk(); ok {
-		// WARNING: This block of code doesn't work as you might expect because of the Fastly API.
-		//
-		// The Fastly API always returns an empty string (and not null). This means
-		// we get a conflict with the state file, as it stores the value as <null>.
-		//
-		// To avoid a "plan was not empty" test error, because Terraform sees the
-		// value was <null> in the state but now is an empty string, we need to
-		// check the state to see if the comment was originally <null> and to force
-		// setting it back to null rather than using the empty string from the API.
-		//
-		// Ideally the Fastly API would return null or omit the field so the API
-		// client could handle whether the value returned was null.
-		//
-		// FIXME: How should we handle 'default values'?
-		// I presume we need to assign a default via attribute schema to avoid
-		// conflicts within Terraform plan diffs.
-		if !state.DefaultHost.IsNull() {
-			state.DefaultHost = types.StringValue(*ptr)
-		}
-	}
+	// NOTE: The Fastly API returns an empty string for an unset default host.
+	defaultHost, _ := clientResp.GetGeneralDefaultHostOk()
+	state.DefaultHost = helpers.NullableString(defaultHost, state.DefaultHost)
 	if ptr, ok := clientResp.GetGeneralDefaultTTLOk(); ok {
 		state.DefaultTTL = types.Int64Value(int64(*ptr))
 	}
@@ -286,3 +437,88 @@ func readServiceSettings(ctx context.Context, serviceVersion int64, state *model
 
 	return nil
 }
+
+// readHTTP3 sets the state of the HTTP/3 setting for the service version.
+//
+// NOTE: The API returns a 404 if HTTP/3 isn't enabled.
+func readHTTP3(ctx context.Context, serviceVersion int64, state *models.ServiceVCL, diags *diag.Diagnostics, api helpers.API) error {
+	clientReq := api.Client.HTTP3API.GetHTTP3(api.ClientCtx, state.ID.ValueString(), int32(serviceVersion))
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			state.HTTP3 = types.BoolValue(false)
+			return nil
+		}
+		tflog.Trace(ctx, "Fastly HTTP3API.GetHTTP3 error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to read HTTP/3 setting")
+		return err
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return err
+	}
+	state.HTTP3 = types.BoolValue(true)
+
+	return nil
+}
+
+// readServiceProducts sets the state of the product enablements.
+//
+// NOTE: All products are read (even if unset in the config) so a product
+// enabled or disabled outside of Terraform is reflected in the state.
+func readServiceProducts(ctx context.Context, state *models.ServiceVCL, diags *diag.Diagnostics, api helpers.API) error {
+	return readProducts(ctx, state.ID.ValueString(), serviceProducts(state), diags, api)
+}
+
+// setUnknownServiceProducts sets the product enablements that are unknown in
+// the plan (i.e. unset in the config, with no prior state to copy from).
+//
+// NOTE: Terraform doesn't allow unknown values once the apply has completed.
+func setUnknownServiceProducts(ctx context.Context, plan *models.ServiceVCL, diags *diag.Diagnostics, api helpers.API) error {
+	var unknown []serviceProduct
+	for _, p := range serviceProducts(plan) {
+		if p.enabled.IsUnknown() {
+			unknown = append(unknown, p)
+		}
+	}
+	return readProducts(ctx, plan.ID.ValueString(), unknown, diags, api)
+}
+
+// readProducts sets the data model attributes of the products.
+func readProducts(ctx context.Context, serviceID string, products []serviceProduct, diags *diag.Diagnostics, api helpers.API) error {
+	// NOTE: Each product is a separate API call, so they're read concurrently.
+	productDiags := make([]diag.Diagnostics, len(products))
+	err := helpers.ForEach(len(products), helpers.MaxConcurrency, func(i int) error {
+		enabled, err := helpers.ProductEnabled(ctx, api, products[i].id, serviceID, &productDiags[i])
+		if err != nil {
+			return err
+		}
+		*products[i].enabled = types.BoolValue(enabled)
+		return nil
+	})
+	for i := range productDiags {
+		diags.Append(productDiags[i]...)
+	}
+
+	return err
+}
+
+// serviceProduct is a product enablement attribute of the service.
+type serviceProduct struct {
+	// id is the product ID accepted by the product enablement API.
+	id string
+	// enabled is the attribute of the data model.
+	enabled *types.Bool
+}
+
+// serviceProducts returns the product enablement attributes of the data model.
+func serviceProducts(data *models.ServiceVCL) []serviceProduct {
+	return []serviceProduct{
+		{helpers.ProductBotManagement, &data.BotManagement},
+		{helpers.ProductBrotliCompression, &data.BrotliCompression},
+		{helpers.ProductDomainInspector, &data.DomainInspector},
+		{helpers.ProductImageOptimizer, &data.ImageOptimizer},
+		{helpers.ProductOriginInspector, &data.OriginInspector},
+		{helpers.ProductWebSockets, &data.WebSockets},
+	}
+}
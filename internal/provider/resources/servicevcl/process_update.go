@@ -2,10 +2,12 @@ package servicevcl
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
+	"time"
 
+	"github.com/fastly/fastly-go/fastly"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -50,23 +52,111 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	// NOTE: The plan data doesn't contain computed attributes.
 	// So we need to read it from the current state.
 	plan.Version = state.Version
+	plan.ClonedVersion = state.ClonedVersion
 	plan.LastActive = state.LastActive
 
 	serviceID := plan.ID.ValueString()
 	serviceVersion := int32(plan.Version.ValueInt64())
 
-	api := helpers.API{
-		Client:    r.client,
-		ClientCtx: r.clientCtx,
+	// A domain deleted from the service might be added to another service in
+	// the same apply, which waits for this service to release it.
+	if plan.Activate.ValueBool() {
+		for _, name := range deletedDomains(plan, state) {
+			helpers.ExpectDomainRelease(name)
+		}
+	}
+
+	timeout, diags := readTimeout(ctx, req.Plan, "update")
+	resp.Diagnostics.Append(diags...)
+	activation, diags := readActivationOptions(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, reportAPITimings := r.newAPI(ctx, "Update", timeout)
+	defer reportAPITimings(&resp.Diagnostics)
+
+	// The service might have been deleted outside of Terraform after the plan
+	// was created. The framework doesn't allow an Update to be turned into a
+	// replacement, so we explain how to recreate the service rather than
+	// surfacing a confusing API error from one of the calls below.
+	serviceDetail, deleted, err := readServiceDetail(ctx, api, serviceID, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	if deleted {
+		tflog.Warn(ctx, "Fastly service deleted outside of Terraform", map[string]any{"id": serviceID})
+		resp.Diagnostics.AddError(
+			helpers.ErrorAPI,
+			fmt.Sprintf("The service %s was deleted outside of Terraform so it can't be updated. Run `terraform apply` again and the next plan will recreate the service.", serviceID),
+		)
+		return
+	}
+
+	// Another actor (e.g. a concurrent CI pipeline) might have changed the
+	// service since the plan was created, in which case applying the plan
+	// could silently clobber their changes.
+	err = checkRemoteSnapshot(ctx, req.Private, serviceDetail, &resp.Diagnostics)
+	if err != nil {
+		return
 	}
 
-	if nestedResourcesChanged {
-		clonedServiceVersion, err := cloneService(ctx, resp, api, serviceID, serviceVersion)
+	// NOTE: Service settings are versioned (unlike the service name/comment).
+	// So a change to the settings requires a new service version.
+	settingsChanged := serviceSettingsChanged(plan, state)
+	versionChanged := nestedResourcesChanged || settingsChanged
+
+	// NOTE: The activation window is checked before any changes are made, so an
+	// apply outside of the window doesn't leave a partially applied draft.
+	if versionChanged && plan.Activate.ValueBool() {
+		err = helpers.CheckActivationWindow(activation.Window, activation.WindowOverride, time.Now(), &resp.Diagnostics)
 		if err != nil {
 			return
 		}
-		plan.Version = types.Int64Value(int64(clonedServiceVersion))
-		serviceVersion = clonedServiceVersion
+	}
+
+	if versionChanged {
+		// If a prior Update failed part way through (e.g. an API error or a
+		// crash), then we'll reuse the draft version it had already cloned.
+		// Otherwise every re-run would orphan another cloned service version.
+		draftServiceVersion, ok := readDraftVersion(ctx, &req, api, serviceID, serviceVersion)
+
+		// The reused draft might already contain some of the nested resource
+		// changes (e.g. a domain added before the prior Update failed). So the
+		// changes are re-inspected against the draft rather than the prior state,
+		// otherwise re-applying them would fail (e.g. with a 409 Conflict).
+		if ok {
+			serviceData := helpers.Service{
+				ID:      serviceID,
+				Version: draftServiceVersion,
+			}
+			if err := inspectDraftChanges(ctx, r.nestedResources, &req, resp, api, serviceData); err != nil {
+				return
+			}
+		}
+
+		// If the user isn't activating their changes, and the version we're
+		// tracking is still a draft, then we'll modify that version in place.
+		// This avoids cloning a new version for every apply while staging changes.
+		if !ok && !plan.Activate.ValueBool() && isDraftVersion(ctx, api, serviceID, serviceVersion) {
+			draftServiceVersion, ok = serviceVersion, true
+		}
+
+		if !ok {
+			clonedServiceVersion, err := cloneService(ctx, resp, api, serviceID, serviceVersion)
+			if err != nil {
+				return
+			}
+			resp.Diagnostics.Append(writeDraftVersion(ctx, resp, clonedServiceVersion)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			draftServiceVersion = clonedServiceVersion
+		}
+		plan.Version = types.Int64Value(int64(draftServiceVersion))
+		plan.ClonedVersion = plan.Version
+		serviceVersion = draftServiceVersion
 	}
 
 	// IMPORTANT: nestedResources are expected to mutate the plan data.
@@ -83,17 +173,41 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		}
 	}
 
-	err = updateServiceSettings(ctx, plan, resp.Diagnostics, api)
-	if err != nil {
-		return
+	if settingsChanged {
+		err = updateServiceSettings(ctx, plan, &resp.Diagnostics, api)
+		if err != nil {
+			return
+		}
+		err = updateHTTP3(ctx, plan, state, &resp.Diagnostics, api)
+		if err != nil {
+			return
+		}
 	}
 
-	if nestedResourcesChanged && plan.Activate.ValueBool() {
-		latestVersion, err := activateService(ctx, plan.ID.ValueString(), serviceVersion, r, resp)
+	var activated bool
+	if versionChanged && plan.Activate.ValueBool() {
+		latestVersion, err := helpers.ActivateService(ctx, plan.ID.ValueString(), serviceVersion, activation, api, &resp.Diagnostics)
 		if err != nil {
 			return
 		}
 		plan.LastActive = types.Int64Value(latestVersion)
+		activated = true
+
+		// NOTE: A domain deleted from the service is only fully released once the
+		// version without it has been activated.
+		for _, name := range deletedDomains(plan, state) {
+			helpers.ReleaseDomain(name)
+		}
+	}
+
+	// We lock the newly activated version, or the currently active version if
+	// the user has only just enabled `lock_active_version`.
+	lockEnabled := plan.LockActiveVersion.ValueBool() && !state.LockActiveVersion.ValueBool()
+	if plan.LockActiveVersion.ValueBool() && !plan.LastActive.IsNull() && (activated || lockEnabled) {
+		err = lockService(ctx, serviceID, int32(plan.LastActive.ValueInt64()), api, &resp.Diagnostics)
+		if err != nil {
+			return
+		}
 	}
 
 	// NOTE: The service attributes (Name, Comment) are 'versionless'.
@@ -105,13 +219,68 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
+	// NOTE: Product enablements are 'versionless' (like the service attributes).
+	err = updateServiceProducts(ctx, plan, state, &resp.Diagnostics, api)
+	if err != nil {
+		return
+	}
+	err = setUnknownServiceProducts(ctx, plan, &resp.Diagnostics, api)
+	if err != nil {
+		return
+	}
+
+	// The draft version was successfully applied so it no longer needs tracking.
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyDraftVersion, nil)...)
+
+	setUnactivatedChanges(plan)
+
+	serviceDetail, err = readActiveVersionMetadata(ctx, plan, &resp.Diagnostics, api)
+	if err != nil {
+		return
+	}
+	resp.Diagnostics.Append(writeRemoteSnapshot(ctx, resp.Private, serviceDetail)...)
+
 	// Save the planned changes into Terraform state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 
-	tflog.Debug(ctx, "Update", map[string]any{"state": fmt.Sprintf("%#v", plan)})
+	tflog.Debug(ctx, "Update", map[string]any{"state": helpers.LogState(plan)})
+}
+
+// deletedDomains returns the names of the domains deleted (or renamed) from
+// the service, which another service might be waiting to create (see
+// helpers.WaitForDomainRelease).
+func deletedDomains(plan, state *models.ServiceVCL) []string {
+	names := make(map[string]bool, len(plan.Domains))
+	for _, domainData := range plan.Domains {
+		names[domainData.Name.ValueString()] = true
+	}
+
+	var deleted []string
+	for _, domainData := range state.Domains {
+		if !names[domainData.Name.ValueString()] {
+			deleted = append(deleted, domainData.Name.ValueString())
+		}
+	}
+	return deleted
+}
+
+// setUnactivatedChanges sets whether the tracked service version differs from
+// the last activated service version.
+func setUnactivatedChanges(plan *models.ServiceVCL) {
+	plan.HasUnactivatedChanges = types.BoolValue(plan.LastActive.IsNull() || !plan.LastActive.Equal(plan.Version))
+}
+
+// serviceSettingsChanged indicates if any of the service settings differ
+// between the plan and the prior state.
+func serviceSettingsChanged(plan, state *models.ServiceVCL) bool {
+	return !plan.DefaultHost.Equal(state.DefaultHost) ||
+		!plan.DefaultTTL.Equal(state.DefaultTTL) ||
+		!plan.HTTP3.Equal(state.HTTP3) ||
+		!plan.StaleIfError.Equal(state.StaleIfError) ||
+		!plan.StaleIfErrorTTL.Equal(state.StaleIfErrorTTL)
 }
 
-func updateServiceSettings(ctx context.Context, plan *models.ServiceVCL, diags diag.Diagnostics, api helpers.API) error {
+func updateServiceSettings(ctx context.Context, plan *models.ServiceVCL, diags *diag.Diagnostics, api helpers.API) error {
 	if plan == nil {
 		return fmt.Errorf("unexpected nil for pointer argument type: %T", plan)
 	}
@@ -121,9 +290,9 @@ func updateServiceSettings(ctx context.Context, plan *models.ServiceVCL, diags d
 
 	clientReq := api.Client.SettingsAPI.UpdateServiceSettings(api.ClientCtx, serviceID, serviceVersion)
 
-	if !plan.DefaultHost.IsNull() {
-		clientReq.GeneralDefaultHost(plan.DefaultHost.ValueString())
-	}
+	// NOTE: A null default host is sent as an empty string so a removed default
+	// host is cleared (the API represents an unset default host as an empty string).
+	clientReq.GeneralDefaultHost(plan.DefaultHost.ValueString())
 	if !plan.DefaultTTL.IsNull() {
 		clientReq.GeneralDefaultTTL(int32(plan.DefaultTTL.ValueInt64()))
 	}
@@ -138,38 +307,44 @@ func updateServiceSettings(ctx context.Context, plan *models.ServiceVCL, diags d
 
 	_, httpResp, err := clientReq.Execute()
 	if err != nil {
-		tflog.Trace(ctx, "Fastly SettingsAPI.UpdateServiceSettings error", map[string]any{"http_resp": httpResp})
-		diags.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to set service settings, got error: %s", err))
+		tflog.Trace(ctx, "Fastly SettingsAPI.UpdateServiceSettings error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to set service settings")
 		return createErr
 	}
 	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode != http.StatusOK {
-		tflog.Trace(ctx, helpers.ErrorAPI, map[string]any{"http_resp": httpResp})
-		diags.AddError(helpers.ErrorAPI, fmt.Sprintf("Unsuccessful status code: %s", httpResp.Status))
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
 		return createErr
 	}
 
 	return nil
 }
 
-// activateService activates the service and updates the plan's LastActive.
-func activateService(
+// lockService locks the service version so it can't be modified out-of-band.
+//
+// NOTE: A locked version can still be cloned.
+// So the next change will be applied to a new (unlocked) draft version.
+func lockService(
 	ctx context.Context,
 	serviceID string,
 	serviceVersion int32,
-	r *Resource,
-	resp *resource.UpdateResponse,
-) (int64, error) {
-	clientReq := r.client.VersionAPI.ActivateServiceVersion(r.clientCtx, serviceID, serviceVersion)
-	clientResp, httpResp, err := clientReq.Execute()
+	api helpers.API,
+	diags *diag.Diagnostics,
+) error {
+	clientReq := api.Client.VersionAPI.LockServiceVersion(api.ClientCtx, serviceID, serviceVersion)
+	_, httpResp, err := clientReq.Execute()
 	if err != nil {
-		tflog.Trace(ctx, "Fastly VersionAPI.ActivateServiceVersion error", map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to activate service version %d, got error: %s", 1, err))
-		return 0, err
+		tflog.Trace(ctx, "Fastly VersionAPI.LockServiceVersion error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, fmt.Sprintf("Unable to lock service version %d", serviceVersion))
+		return err
 	}
 	defer httpResp.Body.Close()
-	return int64(clientResp.GetNumber()), nil
+
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return fmt.Errorf("failed to lock service version %d: %s", serviceVersion, httpResp.Status)
+	}
+
+	return nil
 }
 
 func determineChangesInNestedResources(
@@ -196,6 +371,38 @@ func determineChangesInNestedResources(
 	return resourcesChanged, nil
 }
 
+// inspectDraftChanges replaces the prior state of the nested resources with
+// the contents of the draft service version, and then re-inspects the nested
+// resources for changes, so only the changes missing from the draft are applied.
+func inspectDraftChanges(
+	ctx context.Context,
+	nestedResources []interfaces.Resource,
+	req *resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+	api helpers.API,
+	serviceData helpers.Service,
+) error {
+	// NOTE: All nested resources are read, as the draft might contain entities
+	// for a nested resource attribute that is null in the prior state.
+	readReq := resource.ReadRequest{
+		Private: req.Private,
+		State:   req.State,
+	}
+	readResp := resource.ReadResponse{
+		Private: resp.Private,
+		State:   req.State,
+	}
+	err := readNestedResources(ctx, nestedResources, true, &readReq, &readResp, api, serviceData)
+	resp.Diagnostics.Append(readResp.Diagnostics...)
+	if err != nil {
+		return err
+	}
+	req.State.Raw = readReq.State.Raw
+
+	_, err = determineChangesInNestedResources(ctx, nestedResources, req, resp)
+	return err
+}
+
 func cloneService(
 	ctx context.Context,
 	resp *resource.UpdateResponse,
@@ -206,14 +413,258 @@ func cloneService(
 	clientReq := api.Client.VersionAPI.CloneServiceVersion(api.ClientCtx, serviceID, serviceVersion)
 	clientResp, httpResp, err := clientReq.Execute()
 	if err != nil {
-		tflog.Trace(ctx, "Fastly VersionAPI.CloneServiceVersion error", map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to clone service version, got error: %s", err))
+		tflog.Trace(ctx, "Fastly VersionAPI.CloneServiceVersion error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to clone service version")
 		return 0, err
 	}
 	defer httpResp.Body.Close()
 	return clientResp.GetNumber(), nil
 }
 
+// privateKeyDraftVersion is the private state key used to track a cloned
+// service version that an Update has not yet finished applying changes to.
+const privateKeyDraftVersion = "draft_version"
+
+// draftVersion is the private state data stored for privateKeyDraftVersion.
+type draftVersion struct {
+	ServiceVersion int32 `json:"service_version"`
+}
+
+// readDraftVersion returns the draft service version persisted in the private
+// state by a prior Update that didn't complete.
+//
+// The draft is only returned if it is newer than the version we would otherwise
+// clone from, and if it can still be modified (i.e. it's not locked or active).
+//
+// NOTE: The draft might contain some of the changes from the failed Update.
+// See inspectDraftChanges for how the remaining changes are applied.
+func readDraftVersion(
+	ctx context.Context,
+	req *resource.UpdateRequest,
+	api helpers.API,
+	serviceID string,
+	serviceVersion int32,
+) (int32, bool) {
+	data, diags := req.Private.GetKey(ctx, privateKeyDraftVersion)
+	if diags.HasError() || len(data) == 0 {
+		return 0, false
+	}
+
+	var draft draftVersion
+	if err := json.Unmarshal(data, &draft); err != nil {
+		tflog.Trace(ctx, "Provider error", map[string]any{"error": err, "private_state": string(data)})
+		return 0, false
+	}
+	if draft.ServiceVersion <= serviceVersion {
+		return 0, false
+	}
+
+	if !isDraftVersion(ctx, api, serviceID, draft.ServiceVersion) {
+		return 0, false
+	}
+
+	tflog.Debug(ctx, "Reusing draft service version from private state", map[string]any{"version": draft.ServiceVersion})
+
+	return draft.ServiceVersion, true
+}
+
+// isDraftVersion indicates if the service version can still be modified.
+// i.e. the version exists and it is neither locked nor active.
+func isDraftVersion(ctx context.Context, api helpers.API, serviceID string, serviceVersion int32) bool {
+	clientReq := api.Client.VersionAPI.GetServiceVersion(api.ClientCtx, serviceID, serviceVersion)
+	clientResp, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly VersionAPI.GetServiceVersion error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		return false
+	}
+	defer httpResp.Body.Close()
+
+	if !helpers.IsSuccess(httpResp) {
+		tflog.Trace(ctx, helpers.ErrorAPI, map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		return false
+	}
+
+	return !clientResp.GetLocked() && !clientResp.GetActive()
+}
+
+// writeDraftVersion persists the draft service version in the private state.
+// This enables a subsequent Update to reuse it if the current Update fails.
+func writeDraftVersion(ctx context.Context, resp *resource.UpdateResponse, serviceVersion int32) diag.Diagnostics {
+	data, err := json.Marshal(draftVersion{ServiceVersion: serviceVersion})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(helpers.ErrorProvider, fmt.Sprintf("Unable to marshal draft version for private state, got error: %s", err))
+		return diags
+	}
+	return resp.Private.SetKey(ctx, privateKeyDraftVersion, data)
+}
+
+// privateKeyRemoteSnapshot is the private state key used to record the remote
+// service as it was when the state was last written (i.e. when the plan was
+// created, as the plan refreshes the state).
+const privateKeyRemoteSnapshot = "remote_snapshot"
+
+// remoteSnapshot is the private state data stored for privateKeyRemoteSnapshot.
+type remoteSnapshot struct {
+	LatestVersion int32  `json:"latest_version"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+// privateState is the private state data of a framework response.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// newRemoteSnapshot returns the snapshot of the service details.
+func newRemoteSnapshot(clientResp *fastly.ServiceDetail) remoteSnapshot {
+	var snapshot remoteSnapshot
+	for _, v := range clientResp.GetVersions() {
+		if v.GetNumber() > snapshot.LatestVersion {
+			snapshot.LatestVersion = v.GetNumber()
+		}
+	}
+	if t := clientResp.UpdatedAt.Get(); t != nil {
+		snapshot.UpdatedAt = t.Format(time.RFC3339Nano)
+	}
+	return snapshot
+}
+
+// writeRemoteSnapshot persists the snapshot of the service details in the
+// private state, so a subsequent Update can detect concurrent changes.
+func writeRemoteSnapshot(ctx context.Context, private privateState, clientResp *fastly.ServiceDetail) diag.Diagnostics {
+	data, err := json.Marshal(newRemoteSnapshot(clientResp))
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(helpers.ErrorProvider, fmt.Sprintf("Unable to marshal remote snapshot for private state, got error: %s", err))
+		return diags
+	}
+	return private.SetKey(ctx, privateKeyRemoteSnapshot, data)
+}
+
+// checkRemoteSnapshot returns an error if the service details no longer match
+// the snapshot persisted in the private state when the plan was created.
+//
+// NOTE: The check is skipped if there is no snapshot (e.g. the state was
+// written by an earlier version of the provider).
+func checkRemoteSnapshot(ctx context.Context, private privateState, clientResp *fastly.ServiceDetail, diags *diag.Diagnostics) error {
+	data, d := private.GetKey(ctx, privateKeyRemoteSnapshot)
+	if d.HasError() || len(data) == 0 {
+		return nil
+	}
+
+	var planned remoteSnapshot
+	if err := json.Unmarshal(data, &planned); err != nil {
+		tflog.Trace(ctx, "Provider error", map[string]any{"error": err, "private_state": string(data)})
+		return nil
+	}
+
+	remote := newRemoteSnapshot(clientResp)
+	if remote == planned {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Service changed since the plan was created", map[string]any{"planned": planned, "remote": remote})
+	diags.AddError(
+		helpers.ErrorAPI,
+		fmt.Sprintf(
+			"The service %s was changed by another actor after the plan was created (latest version %d, updated at %s; now latest version %d, updated at %s), so the plan wasn't applied to avoid overwriting those changes. Run `terraform plan` again to review the changes.",
+			clientResp.GetID(), planned.LatestVersion, planned.UpdatedAt, remote.LatestVersion, remote.UpdatedAt,
+		),
+	)
+	return errors.New("service changed since the plan was created")
+}
+
+// updateHTTP3 enables/disables HTTP/3 for the plan's service version.
+//
+// The state is nil when creating the service, in which case HTTP/3 only needs
+// to be updated if it's enabled (as it's disabled by default). Otherwise the
+// service version was cloned from the prior state's version, so HTTP/3 only
+// needs to be updated if it has changed.
+func updateHTTP3(ctx context.Context, plan, state *models.ServiceVCL, diags *diag.Diagnostics, api helpers.API) error {
+	if plan == nil {
+		return fmt.Errorf("unexpected nil for pointer argument type: %T", plan)
+	}
+
+	// A null prior value (i.e. there's no prior state) means disabled.
+	var current models.ServiceVCL
+	if state != nil {
+		current = *state
+	}
+	if plan.HTTP3.IsNull() || plan.HTTP3.IsUnknown() || plan.HTTP3.ValueBool() == current.HTTP3.ValueBool() {
+		return nil
+	}
+
+	serviceID := plan.ID.ValueString()
+	serviceVersion := int32(plan.Version.ValueInt64())
+
+	if plan.HTTP3.ValueBool() {
+		clientReq := api.Client.HTTP3API.CreateHTTP3(api.ClientCtx, serviceID, serviceVersion)
+		_, httpResp, err := clientReq.Execute()
+		if err != nil {
+			tflog.Trace(ctx, "Fastly HTTP3API.CreateHTTP3 error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+			helpers.APIError(httpResp, err, diags, fmt.Sprintf("Unable to enable HTTP/3 for service version %d", serviceVersion))
+			return err
+		}
+		defer httpResp.Body.Close()
+		return helpers.CheckStatus(ctx, httpResp, diags)
+	}
+
+	clientReq := api.Client.HTTP3API.DeleteHTTP3(api.ClientCtx, serviceID, serviceVersion)
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		// HTTP/3 is already disabled for the service version.
+		if helpers.IsNotFound(httpResp) {
+			return nil
+		}
+		tflog.Trace(ctx, "Fastly HTTP3API.DeleteHTTP3 error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, fmt.Sprintf("Unable to disable HTTP/3 for service version %d", serviceVersion))
+		return err
+	}
+	defer httpResp.Body.Close()
+	return helpers.CheckStatus(ctx, httpResp, diags)
+}
+
+// updateServiceProducts enables/disables the products that have changed.
+//
+// The state is nil when creating the service, in which case only enabled
+// products need to be updated (as all products are disabled by default).
+//
+// NOTE: A product that is unset in the config (i.e. unknown in the plan when
+// there's no prior state) isn't managed, so it's left as it is.
+func updateServiceProducts(ctx context.Context, plan, state *models.ServiceVCL, diags *diag.Diagnostics, api helpers.API) error {
+	if plan == nil {
+		return fmt.Errorf("unexpected nil for pointer argument type: %T", plan)
+	}
+
+	serviceID := plan.ID.ValueString()
+
+	// A null prior value (i.e. there's no prior state) means disabled.
+	var current models.ServiceVCL
+	if state != nil {
+		current = *state
+	}
+
+	currentProducts := serviceProducts(&current)
+	for i, p := range serviceProducts(plan) {
+		planned, enabled := *p.enabled, *currentProducts[i].enabled
+		if planned.IsNull() || planned.IsUnknown() || planned.ValueBool() == enabled.ValueBool() {
+			continue
+		}
+		var err error
+		if planned.ValueBool() {
+			err = helpers.EnableProduct(ctx, api, p.id, serviceID, diags)
+		} else {
+			err = helpers.DisableProduct(ctx, api, p.id, serviceID, diags)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func updateServiceAttributes(
 	ctx context.Context,
 	plan *models.ServiceVCL,
@@ -236,8 +687,8 @@ func updateServiceAttributes(
 
 	_, httpResp, err := clientReq.Execute()
 	if err != nil {
-		tflog.Trace(ctx, "Fastly ServiceAPI.UpdateService error", map[string]any{"http_resp": httpResp})
-		resp.Diagnostics.AddError(helpers.ErrorAPIClient, fmt.Sprintf("Unable to update service, got error: %s", err))
+		tflog.Trace(ctx, "Fastly ServiceAPI.UpdateService error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to update service")
 		return err
 	}
 	defer httpResp.Body.Close()
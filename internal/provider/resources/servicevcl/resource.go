@@ -6,21 +6,30 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/fastly/fastly-go/fastly"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
 	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/interfaces"
-	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/domain"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/registry"
+	// The nested resource packages register themselves with the registry.
+	_ "github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/dictionary"
+	_ "github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/domain"
+	_ "github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/loggingkafka"
+	_ "github.com/integralist/terraform-provider-fastly-framework/internal/provider/resources/loggingkinesis"
 	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/schemas"
 )
 
@@ -33,26 +42,30 @@ var resourceDescription string
 // https://pkg.go.dev/github.com/hashicorp/terraform-plugin-framework/resource#ResourceWithConfigValidators
 // https://pkg.go.dev/github.com/hashicorp/terraform-plugin-framework/resource#ResourceWithConfigure
 // https://pkg.go.dev/github.com/hashicorp/terraform-plugin-framework/resource#ResourceWithImportState
+// https://pkg.go.dev/github.com/hashicorp/terraform-plugin-framework/resource#ResourceWithModifyPlan
+// https://pkg.go.dev/github.com/hashicorp/terraform-plugin-framework/resource#ResourceWithValidateConfig
 var (
 	_ resource.Resource                     = &Resource{}
 	_ resource.ResourceWithConfigValidators = &Resource{}
 	_ resource.ResourceWithConfigure        = &Resource{}
 	_ resource.ResourceWithImportState      = &Resource{}
+	_ resource.ResourceWithModifyPlan       = &Resource{}
+	_ resource.ResourceWithValidateConfig   = &Resource{}
 )
 
 // NewResource returns a new Terraform resource instance.
 func NewResource() func() resource.Resource {
 	return func() resource.Resource {
 		return &Resource{
-			nestedResources: []interfaces.Resource{
-				domain.NewResource(),
-			},
+			nestedResources: registry.NestedResources(helpers.ServiceTypeVCL),
 		}
 	}
 }
 
 // Resource defines the resource implementation.
 type Resource struct {
+	// apiTiming controls the reporting of API call timings.
+	apiTiming helpers.APITiming
 	// client is a preconfigured instance of the Fastly API client.
 	client *fastly.APIClient
 	// clientCtx contains the user's API token.
@@ -64,6 +77,12 @@ type Resource struct {
 	// As our nested resources are actually just nested 'attributes'.
 	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/attributes#nested-attributes
 	nestedResources []interfaces.Resource
+	// token describes the user's API token.
+	token *helpers.TokenInfo
+	// tracer exports the spans of each operation (nil if tracing is disabled).
+	tracer *helpers.Tracer
+	// warnDuplicateNames enables the plan-time duplicate service name check.
+	warnDuplicateNames bool
 }
 
 // Metadata should return the full name of the resource.
@@ -76,12 +95,18 @@ func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, res
 // NOTE: Some optional attributes are also 'computed' so we can set a default.
 func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	attrs := schemas.Service()
+	for name, attr := range registry.Schemas(helpers.ServiceTypeVCL) {
+		attrs[name] = attr
+	}
 
 	attrs["default_ttl"] = schema.Int64Attribute{
 		Computed:            true,
 		MarkdownDescription: "The default Time-to-live (TTL) for requests",
 		Optional:            true,
 		Default:             int64default.StaticInt64(3600),
+		PlanModifiers: []planmodifier.Int64{
+			schemas.ServerManaged{},
+		},
 	}
 	attrs["default_host"] = schema.StringAttribute{
 		MarkdownDescription: "The default hostname",
@@ -92,12 +117,24 @@ func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *res
 		MarkdownDescription: "Enables serving a stale object if there is an error",
 		Optional:            true,
 		Default:             booldefault.StaticBool(false),
+		PlanModifiers: []planmodifier.Bool{
+			schemas.ServerManaged{},
+		},
 	}
 	attrs["stale_if_error_ttl"] = schema.Int64Attribute{
 		Computed:            true,
 		MarkdownDescription: "The default time-to-live (TTL) for serving the stale object for the version",
 		Optional:            true,
 		Default:             int64default.StaticInt64(43200),
+		PlanModifiers: []planmodifier.Int64{
+			schemas.ServerManaged{},
+		},
+	}
+	attrs[schemas.IgnoreServerManagedSettings] = schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Ignores differences for the settings that aren't configured (`default_ttl`, `stale_if_error` and `stale_if_error_ttl`), so a value managed by Fastly (e.g. a default injected into a new service version) is kept instead of being reset to the provider's default. Default `false`",
+		Optional:            true,
+		Default:             booldefault.StaticBool(false),
 	}
 
 	resp.Schema = schema.Schema{
@@ -116,18 +153,145 @@ func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, r
 		return
 	}
 
-	client, ok := req.ProviderData.(*fastly.APIClient)
+	providerData, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *fastly.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.apiTiming = providerData.APITiming
+	r.tracer = providerData.Tracer
+	r.client = providerData.Client
 	r.clientCtx = fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv)
+	r.token = providerData.Token
+	r.warnDuplicateNames = providerData.WarnDuplicateServiceNames
+}
+
+// newAPI returns the API helper to use for a single CRUD operation.
+//
+// If API call timing instrumentation is enabled, then the API client context
+// will record the timings of each API call, and the returned function should
+// be deferred so the timings are reported once the operation completes.
+//
+// If tracing is enabled, then the operation is recorded as a span (with each
+// API call as a child span), which the returned function exports.
+//
+// If timeout is non-zero, then the API client context is cancelled once the
+// timeout elapses, and the returned function also releases that context.
+func (r *Resource) newAPI(ctx context.Context, operation string, timeout time.Duration) (helpers.API, func(diags *diag.Diagnostics)) {
+	api := helpers.API{
+		Client:    r.client,
+		ClientCtx: r.clientCtx,
+	}
+
+	cancel := context.CancelFunc(func() {})
+	if timeout > 0 {
+		api.ClientCtx, cancel = context.WithTimeout(api.ClientCtx, timeout)
+	}
+
+	api, finishSpan := r.tracer.Operation(ctx, api, "fastly_service_vcl."+operation)
+
+	var timings *helpers.APITimings
+	if r.apiTiming != helpers.APITimingOff {
+		api.ClientCtx, timings = helpers.WithAPITimings(api.ClientCtx)
+	}
+
+	return api, func(diags *diag.Diagnostics) {
+		cancel()
+		helpers.ReportAPITimings(ctx, operation, r.apiTiming, timings, diags)
+		finishSpan(diags)
+	}
+}
+
+// attributeGetter is implemented by the plan and state request data.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target any) diag.Diagnostics
+}
+
+// readTimeout returns the configured timeout for the given operation.
+//
+// The operation is the name of an attribute within the `timeouts` attribute.
+// A zero duration is returned if no timeout has been configured.
+func readTimeout(ctx context.Context, data attributeGetter, operation string) (time.Duration, diag.Diagnostics) {
+	var timeout types.String
+	diags := data.GetAttribute(ctx, path.Root("timeouts").AtName(operation), &timeout)
+	if diags.HasError() || timeout.IsNull() || timeout.IsUnknown() {
+		return 0, diags
+	}
+
+	d, err := time.ParseDuration(timeout.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("timeouts").AtName(operation),
+			"Invalid Timeout",
+			fmt.Sprintf("Unable to parse the %s timeout, got error: %s", operation, err),
+		)
+		return 0, diags
+	}
+
+	return d, diags
+}
+
+// readActivationOptions returns the configured activation options.
+func readActivationOptions(ctx context.Context, data attributeGetter) (helpers.ActivationOptions, diag.Diagnostics) {
+	var opts helpers.ActivationOptions
+	var diags diag.Diagnostics
+
+	timeout, d := readTimeout(ctx, data, "activate")
+	diags.Append(d...)
+	opts.Timeout = timeout
+
+	interval, d := readTimeout(ctx, data, "activate_poll_interval")
+	diags.Append(d...)
+	opts.Interval = interval
+
+	diags.Append(data.GetAttribute(ctx, path.Root("activation_window"), &opts.Window)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("activation_window_override"), &opts.WindowOverride)...)
+
+	var waitForDeployment types.Bool
+	diags.Append(data.GetAttribute(ctx, path.Root("wait_for_deployment"), &waitForDeployment)...)
+	opts.WaitForDeployment = waitForDeployment.ValueBool()
+
+	deploymentTimeout, d := readTimeout(ctx, data, "deployment")
+	diags.Append(d...)
+	opts.DeploymentTimeout = deploymentTimeout
+
+	return opts, diags
+}
+
+// serviceDeleted reports whether the service has been deleted outside of
+// Terraform, either because the API no longer knows about the service (404) or
+// because the service has a `deleted_at` timestamp.
+func serviceDeleted(ctx context.Context, api helpers.API, serviceID string, diags *diag.Diagnostics) (bool, error) {
+	_, deleted, err := readServiceDetail(ctx, api, serviceID, diags)
+	return deleted, err
+}
+
+// readServiceDetail returns the service details, and reports whether the
+// service has been deleted outside of Terraform (see serviceDeleted).
+func readServiceDetail(ctx context.Context, api helpers.API, serviceID string, diags *diag.Diagnostics) (*fastly.ServiceDetail, bool, error) {
+	clientReq := api.Client.ServiceAPI.GetServiceDetail(api.ClientCtx, serviceID)
+	clientResp, httpResp, err := clientReq.Execute()
+	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			return nil, true, nil
+		}
+		tflog.Trace(ctx, "Fastly ServiceAPI.GetServiceDetail error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to retrieve service details")
+		return nil, false, err
+	}
+	defer httpResp.Body.Close()
+
+	if t, ok := clientResp.GetDeletedAtOk(); ok && t != nil {
+		tflog.Trace(ctx, "Fastly ServiceAPI.GetDeletedAtOk", map[string]any{"deleted_at": t, "service_id": serviceID})
+		return clientResp, true, nil
+	}
+
+	return clientResp, false, nil
 }
 
 // ImportState is called when the provider must import the state of a resource instance.
@@ -167,7 +331,7 @@ func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequ
 	var state map[string]tftypes.Value
 	err := resp.State.Raw.As(&state)
 	if err == nil {
-		tflog.Trace(ctx, "ImportState", map[string]any{"state": fmt.Sprintf("%#v", state)})
+		tflog.Trace(ctx, "ImportState", map[string]any{"state": helpers.LogState(state)})
 	}
 }
 
@@ -181,3 +345,66 @@ func (r Resource) ConfigValidators(_ context.Context) []resource.ConfigValidator
 		),
 	}
 }
+
+// ValidateConfig validates the resource configuration.
+//
+// NOTE: A service can be drafted without any domains (`activate = false`) but
+// the Fastly API requires at least one domain to activate a service.
+func (r Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var activate types.Bool
+	var domains types.Map
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("activate"), &activate)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("domains"), &domains)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if domains.IsUnknown() {
+		return
+	}
+
+	validateDomainNames(ctx, req, resp)
+
+	// NOTE: A null `activate` defaults to `true`.
+	if activate.IsUnknown() || (!activate.IsNull() && !activate.ValueBool()) {
+		return
+	}
+
+	if domains.IsNull() || len(domains.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("domains"),
+			"Missing Domains",
+			"At least one domain is required to activate a service. Set `activate = false` to create a draft service without any domains.",
+		)
+	}
+}
+
+// validateDomainNames checks no two domains share the same name.
+//
+// NOTE: The API would reject the duplicate domain with a conflict, which is
+// otherwise indistinguishable from the domain belonging to another service.
+func validateDomainNames(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var domains map[string]models.Domain
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("domains"), &domains)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]string, len(domains))
+	for domainID, domainData := range domains {
+		if domainData.Name.IsNull() || domainData.Name.IsUnknown() {
+			continue
+		}
+		name := strings.ToLower(domainData.Name.ValueString())
+		if otherID, ok := seen[name]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("domains").AtMapKey(domainID).AtName("name"),
+				"Duplicate Domain Name",
+				fmt.Sprintf("The domain name '%s' is also used by the domain '%s'.", domainData.Name.ValueString(), otherID),
+			)
+			continue
+		}
+		seen[name] = domainID
+	}
+}
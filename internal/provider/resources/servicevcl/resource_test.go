@@ -0,0 +1,214 @@
+package servicevcl
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// NOTE: Each API on the fastly.APIClient is an interface (e.g. ServiceAPI).
+// The fakes below embed the interface so only the methods under test need an
+// implementation (any other method call will panic, which fails the test).
+
+// fakeServiceAPI returns a fixed response for ServiceAPI.GetServiceDetail.
+type fakeServiceAPI struct {
+	fastly.ServiceAPI
+	detail *fastly.ServiceDetail
+	status int
+}
+
+func (f *fakeServiceAPI) GetServiceDetail(_ context.Context, _ string) fastly.APIGetServiceDetailRequest {
+	return fastly.APIGetServiceDetailRequest{APIService: f}
+}
+
+func (f *fakeServiceAPI) GetServiceDetailExecute(_ fastly.APIGetServiceDetailRequest) (*fastly.ServiceDetail, *http.Response, error) {
+	httpResp, err := fakeResponse(f.status)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	return f.detail, httpResp, nil
+}
+
+// fakeVersionAPI returns a fixed response for VersionAPI.GetServiceVersion.
+type fakeVersionAPI struct {
+	fastly.VersionAPI
+	version *fastly.VersionResponse
+	status  int
+}
+
+func (f *fakeVersionAPI) GetServiceVersion(_ context.Context, _ string, _ int32) fastly.APIGetServiceVersionRequest {
+	return fastly.APIGetServiceVersionRequest{APIService: f}
+}
+
+func (f *fakeVersionAPI) GetServiceVersionExecute(_ fastly.APIGetServiceVersionRequest) (*fastly.VersionResponse, *http.Response, error) {
+	httpResp, err := fakeResponse(f.status)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	return f.version, httpResp, nil
+}
+
+// fakeResponse mimics the fastly-go client, which returns an error for any
+// unsuccessful status code.
+func fakeResponse(status int) (*http.Response, error) {
+	httpResp := &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+	if status >= http.StatusMultipleChoices {
+		return httpResp, errors.New(http.StatusText(status))
+	}
+	return httpResp, nil
+}
+
+func TestServiceDeleted(t *testing.T) {
+	deleted := fastly.NewServiceDetail()
+	deleted.SetDeletedAt(time.Now())
+
+	tests := []struct {
+		name        string
+		api         *fakeServiceAPI
+		wantDeleted bool
+		wantErr     bool
+	}{
+		{
+			name: "active service",
+			api:  &fakeServiceAPI{detail: fastly.NewServiceDetail(), status: http.StatusOK},
+		},
+		{
+			name:        "deleted_at is set",
+			api:         &fakeServiceAPI{detail: deleted, status: http.StatusOK},
+			wantDeleted: true,
+		},
+		{
+			name:        "not found",
+			api:         &fakeServiceAPI{status: http.StatusNotFound},
+			wantDeleted: true,
+		},
+		{
+			name:    "api error",
+			api:     &fakeServiceAPI{status: http.StatusInternalServerError},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := helpers.API{
+				Client:    &fastly.APIClient{ServiceAPI: tt.api},
+				ClientCtx: context.Background(),
+			}
+
+			var diags diag.Diagnostics
+			got, err := serviceDeleted(context.Background(), api, "123", &diags)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("want error %t, got: %v", tt.wantErr, err)
+			}
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("want error diagnostics %t, got: %v", tt.wantErr, diags)
+			}
+			if got != tt.wantDeleted {
+				t.Errorf("want deleted %t, got: %t", tt.wantDeleted, got)
+			}
+		})
+	}
+}
+
+func TestIsDraftVersion(t *testing.T) {
+	newVersion := func(active, locked bool) *fastly.VersionResponse {
+		v := fastly.NewVersionResponse()
+		v.SetActive(active)
+		v.SetLocked(locked)
+		return v
+	}
+
+	tests := []struct {
+		name string
+		api  *fakeVersionAPI
+		want bool
+	}{
+		{
+			name: "draft",
+			api:  &fakeVersionAPI{version: newVersion(false, false), status: http.StatusOK},
+			want: true,
+		},
+		{
+			name: "active",
+			api:  &fakeVersionAPI{version: newVersion(true, false), status: http.StatusOK},
+		},
+		{
+			name: "locked",
+			api:  &fakeVersionAPI{version: newVersion(false, true), status: http.StatusOK},
+		},
+		{
+			name: "not found",
+			api:  &fakeVersionAPI{status: http.StatusNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := helpers.API{
+				Client:    &fastly.APIClient{VersionAPI: tt.api},
+				ClientCtx: context.Background(),
+			}
+
+			if got := isDraftVersion(context.Background(), api, "123", 1); got != tt.want {
+				t.Errorf("want %t, got: %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestChangeSummary(t *testing.T) {
+	changes := []string{
+		"domains: " + changeCounts(2, 0, 1),
+		"backends: " + changeCounts(0, 1, 0),
+	}
+
+	want := "domains: 2 added, 1 modified; backends: 1 deleted. A new service version will be cloned from version 3 and activated."
+	if got := changeSummary(changes, true, 3); got != want {
+		t.Errorf("changeSummary() = %q, want %q", got, want)
+	}
+
+	if got := changeSummary(changes, false, 3); !strings.Contains(got, "won't be activated") {
+		t.Errorf("expected summary to explain the version won't be activated, got %q", got)
+	}
+}
+
+// TestSetServiceMetadata validates the service metadata is read from the
+// service details, and an unset value is null.
+func TestSetServiceMetadata(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var data models.ServiceVCL
+	setServiceMetadata(&data, &fastly.ServiceDetail{
+		CreatedAt:  *fastly.NewNullableTime(&created),
+		CustomerID: fastly.PtrString("customer123"),
+	})
+
+	if got := data.CreatedAt.ValueString(); got != "2024-01-02T03:04:05Z" {
+		t.Errorf("want created_at 2024-01-02T03:04:05Z, got %q", got)
+	}
+	if got := data.CustomerID.ValueString(); got != "customer123" {
+		t.Errorf("want customer_id customer123, got %q", got)
+	}
+	if !data.UpdatedAt.IsNull() {
+		t.Errorf("want a null updated_at, got %s", data.UpdatedAt)
+	}
+
+	setServiceMetadata(&data, &fastly.ServiceDetail{})
+	if !data.CustomerID.IsNull() {
+		t.Errorf("want a null customer_id, got %s", data.CustomerID)
+	}
+}
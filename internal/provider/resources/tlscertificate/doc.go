@@ -0,0 +1,2 @@
+// Package tlscertificate implements a custom TLS certificate resource.
+package tlscertificate
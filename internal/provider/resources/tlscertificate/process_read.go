@@ -0,0 +1,103 @@
+package tlscertificate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Read is called when the provider must read resource values in order to update state.
+// Planned state values should be read from the ReadRequest.
+// New state values set on the ReadResponse.
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	api, finishSpan := r.newAPI(ctx, "Read")
+	defer finishSpan(&resp.Diagnostics)
+
+	var state *models.TLSCertificate
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after state population")
+		return
+	}
+
+	found, err := r.read(ctx, api, state, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	// The certificate was deleted outside of Terraform, so the next plan will
+	// upload it again.
+	if !found {
+		tflog.Warn(ctx, "Fastly TLS certificate not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save the updated state data back into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Read", map[string]any{"state": helpers.LogState(state)})
+}
+
+// read populates the computed attributes from the API, and reports whether the
+// certificate exists.
+func (r *Resource) read(ctx context.Context, api helpers.API, data *models.TLSCertificate, diags *diag.Diagnostics) (bool, error) {
+	clientReq := api.Client.TLSCertificatesAPI.GetTLSCert(api.ClientCtx, data.ID.ValueString())
+	clientResp, httpResp, err := clientReq.Execute()
+	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			return false, nil
+		}
+		tflog.Trace(ctx, "Fastly TLSCertificatesAPI.GetTLSCert error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, diags, "Unable to read TLS certificate")
+		return false, err
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, diags); err != nil {
+		return false, err
+	}
+
+	certData := clientResp.GetData()
+	attrs := certData.GetAttributes()
+
+	var domains []string
+	for _, domain := range certData.Relationships.GetTLSDomains().Data {
+		domains = append(domains, domain.GetID())
+	}
+	domainsValue, d := types.SetValueFrom(ctx, types.StringType, domains)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false, fmt.Errorf("unable to set the TLS certificate domains")
+	}
+
+	data.CreatedAt = helpers.Timestamp(attrs.CreatedAt)
+	data.Domains = domainsValue
+	data.IssuedTo = types.StringValue(attrs.GetIssuedTo())
+	data.Issuer = types.StringValue(attrs.GetIssuer())
+	// NOTE: The API client doesn't model the certificate name, so it's read
+	// from the undecoded attributes.
+	if name, ok := attrs.AdditionalProperties["name"].(string); ok {
+		data.Name = types.StringValue(name)
+	} else if data.Name.IsUnknown() {
+		data.Name = types.StringNull()
+	}
+	data.NotAfter = helpers.Timestamp(*fastly.NewNullableTime(attrs.NotAfter))
+	data.NotBefore = helpers.Timestamp(*fastly.NewNullableTime(attrs.NotBefore))
+	data.Replace = types.BoolValue(attrs.GetReplace())
+	data.SerialNumber = types.StringValue(attrs.GetSerialNumber())
+	data.SignatureAlgorithm = types.StringValue(attrs.GetSignatureAlgorithm())
+	data.UpdatedAt = helpers.Timestamp(attrs.UpdatedAt)
+
+	return true, nil
+}
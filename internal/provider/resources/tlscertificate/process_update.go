@@ -0,0 +1,92 @@
+package tlscertificate
+
+import (
+	"context"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider/models"
+)
+
+// Update is called to update the state of the resource.
+// Config, planned state, and prior state values should be read from the UpdateRequest.
+// New state values set on the UpdateResponse.
+//
+// A changed certificate replaces the certificate in-place, so the domains
+// using the certificate keep serving traffic. A certificate with a different
+// key replaces the resource instead (see keyChanged).
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	api, finishSpan := r.newAPI(ctx, "Update")
+	defer finishSpan(&resp.Diagnostics)
+
+	var plan *models.TLSCertificate
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after plan population")
+		return
+	}
+
+	var state *models.TLSCertificate
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil {
+		tflog.Trace(ctx, helpers.ErrorTerraformPointer, map[string]any{"req": req, "resp": resp})
+		resp.Diagnostics.AddError(helpers.ErrorTerraformPointer, "nil pointer after state population")
+		return
+	}
+
+	// NOTE: The certificate is only sent if it has changed, as the API rejects
+	// replacing a certificate with itself.
+	certChanged := !plan.CertificateBlob.Equal(state.CertificateBlob)
+
+	clientReq := api.Client.TLSCertificatesAPI.UpdateTLSCert(api.ClientCtx, state.ID.ValueString())
+	clientReq.TLSCertificate(certificateRequest(plan, certChanged))
+
+	_, httpResp, err := clientReq.Execute()
+	if err != nil {
+		tflog.Trace(ctx, "Fastly TLSCertificatesAPI.UpdateTLSCert error", map[string]any{"http_resp": helpers.LogResponse(httpResp)})
+		helpers.APIError(httpResp, err, &resp.Diagnostics, "Unable to update TLS certificate")
+		return
+	}
+	defer httpResp.Body.Close()
+	if err := helpers.CheckStatus(ctx, httpResp, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	if _, err := r.read(ctx, api, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+
+	// Save the planned changes into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Update", map[string]any{"state": helpers.LogState(plan)})
+}
+
+// certificateRequest returns the API request body for the certificate.
+func certificateRequest(data *models.TLSCertificate, includeCert bool) fastly.TLSCertificate {
+	attrs := fastly.TLSCertificateDataAttributes{}
+	if includeCert {
+		attrs.CertBlob = fastly.PtrString(data.CertificateBlob.ValueString())
+	}
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		attrs.Name = fastly.PtrString(data.Name.ValueString())
+	}
+
+	certType := fastly.TYPETLSCERTIFICATE_TLS_CERTIFICATE
+	return fastly.TLSCertificate{
+		Data: &fastly.TLSCertificateData{
+			Type:       &certType,
+			Attributes: &attrs,
+		},
+	}
+}
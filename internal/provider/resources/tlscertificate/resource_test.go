@@ -0,0 +1,86 @@
+package tlscertificate
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testCertificate returns a self-signed PEM-encoded certificate for the key.
+func testCertificate(t *testing.T, key *ecdsa.PrivateKey, serial int64) string {
+	t.Helper()
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "www.example.com"},
+		DNSNames:     []string{"www.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %s", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func testKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+	return key
+}
+
+func TestKeyChanged(t *testing.T) {
+	key := testKey(t)
+	cert := testCertificate(t, key, 1)
+
+	tests := map[string]struct {
+		state types.String
+		plan  types.String
+		want  bool
+	}{
+		"renewed certificate with the same key": {
+			state: types.StringValue(cert),
+			plan:  types.StringValue(testCertificate(t, key, 2)),
+		},
+		"certificate with a different key": {
+			state: types.StringValue(cert),
+			plan:  types.StringValue(testCertificate(t, testKey(t), 3)),
+			want:  true,
+		},
+		"no prior certificate": {
+			state: types.StringNull(),
+			plan:  types.StringValue(cert),
+		},
+		"invalid prior certificate": {
+			state: types.StringValue("-----BEGIN CERTIFICATE-----"),
+			plan:  types.StringValue(cert),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.StringRequest{StateValue: tc.state, PlanValue: tc.plan}
+			var resp stringplanmodifier.RequiresReplaceIfFuncResponse
+			keyChanged(context.Background(), req, &resp)
+			if resp.RequiresReplace != tc.want {
+				t.Errorf("want RequiresReplace %t, got: %t", tc.want, resp.RequiresReplace)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package schemas
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// timeOfDayRegex matches a 24-hour time of day (e.g. 17:30).
+var timeOfDayRegex = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// ActivationWindow returns the schema attributes that restrict when a service
+// version can be activated (see helpers.ActivationWindow).
+func ActivationWindow() map[string]schema.Attribute {
+	timeOfDay := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(timeOfDayRegex, "must be a 24-hour time of day such as 09:00 or 17:30"),
+			},
+		}
+	}
+
+	return map[string]schema.Attribute{
+		"activation_window": schema.SingleNestedAttribute{
+			MarkdownDescription: "Restricts the activation of a service version to a recurring window (e.g. to protect a change freeze). An apply that would activate a version outside of the window fails, unless `activation_window_override` is `true`",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"days": schema.ListAttribute{
+					ElementType:         types.StringType,
+					MarkdownDescription: "The days of the week the window opens on. Each is one of `mon`, `tue`, `wed`, `thu`, `fri`, `sat` or `sun`. Defaults to every day",
+					Optional:            true,
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+						listvalidator.UniqueValues(),
+						listvalidator.ValueStringsAre(stringvalidator.OneOf("mon", "tue", "wed", "thu", "fri", "sat", "sun")),
+					},
+				},
+				"end":   timeOfDay("The time of day (`HH:MM`) the window closes. A window that ends before it starts spans midnight (e.g. `22:00` to `02:00`)"),
+				"start": timeOfDay("The time of day (`HH:MM`) the window opens"),
+				"time_zone": schema.StringAttribute{
+					MarkdownDescription: "The IANA time zone of the window (e.g. `Europe/London`). Default `UTC`",
+					Optional:            true,
+					Validators: []validator.String{
+						timeZone{},
+					},
+				},
+			},
+		},
+		"activation_window_override": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Allows a service version to be activated outside of the `activation_window` (e.g. for an emergency fix). Default `false`",
+			Optional:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+	}
+}
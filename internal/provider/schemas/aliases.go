@@ -0,0 +1,60 @@
+package schemas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BoolAlias returns a deprecated attribute that is accepted in place of the
+// replacement attribute (e.g. an old spelling of a renamed attribute), so a
+// schema rename doesn't break existing configurations.
+//
+// The replacement attribute must be Optional+Computed and use the AliasedBy
+// plan modifier, which migrates the deprecated value into the replacement
+// attribute (and so the state) where the rest of the provider reads it from.
+func BoolAlias(replacement string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		DeprecationMessage:  fmt.Sprintf("Use `%s` instead. This attribute will be removed in a future major version.", replacement),
+		MarkdownDescription: fmt.Sprintf("**Deprecated:** an alias for `%s`", replacement),
+		Optional:            true,
+		Validators: []validator.Bool{
+			boolvalidator.ConflictsWith(path.MatchRoot(replacement)),
+		},
+	}
+}
+
+// AliasedBy is a plan modifier for an attribute that has a deprecated alias
+// (see BoolAlias). If the attribute isn't configured, then the value of the
+// alias is planned (or null if the alias isn't configured either).
+type AliasedBy struct {
+	// Alias is the name of the deprecated attribute.
+	Alias string
+}
+
+func (m AliasedBy) Description(_ context.Context) string {
+	return fmt.Sprintf("If not configured, set to the value of the deprecated %s attribute.", m.Alias)
+}
+
+func (m AliasedBy) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m AliasedBy) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var alias types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName(m.Alias), &alias)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.PlanValue = alias
+}
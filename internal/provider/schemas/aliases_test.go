@@ -0,0 +1,80 @@
+package schemas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAliasedBy(t *testing.T) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"force":         BoolAlias("force_destroy"),
+			"force_destroy": schema.BoolAttribute{Optional: true, Computed: true},
+		},
+	}
+	configType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"force":         tftypes.Bool,
+		"force_destroy": tftypes.Bool,
+	}}
+
+	tests := map[string]struct {
+		alias       any
+		configValue types.Bool
+		want        types.Bool
+	}{
+		"alias migrated": {
+			alias:       true,
+			configValue: types.BoolNull(),
+			want:        types.BoolValue(true),
+		},
+		"configured value": {
+			alias:       nil,
+			configValue: types.BoolValue(false),
+			want:        types.BoolValue(false),
+		},
+		"neither configured": {
+			alias:       nil,
+			configValue: types.BoolNull(),
+			want:        types.BoolNull(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var forceDestroy any
+			if !tc.configValue.IsNull() {
+				forceDestroy = tc.configValue.ValueBool()
+			}
+			config := tfsdk.Config{
+				Schema: s,
+				Raw: tftypes.NewValue(configType, map[string]tftypes.Value{
+					"force":         tftypes.NewValue(tftypes.Bool, tc.alias),
+					"force_destroy": tftypes.NewValue(tftypes.Bool, forceDestroy),
+				}),
+			}
+
+			req := planmodifier.BoolRequest{
+				Config:      config,
+				ConfigValue: tc.configValue,
+				Path:        path.Root("force_destroy"),
+				PlanValue:   tc.configValue,
+			}
+			resp := &planmodifier.BoolResponse{PlanValue: req.PlanValue}
+			AliasedBy{Alias: "force"}.PlanModifyBool(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tc.want) {
+				t.Errorf("want plan value %s, got: %s", tc.want, resp.PlanValue)
+			}
+		})
+	}
+}
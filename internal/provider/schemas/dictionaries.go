@@ -0,0 +1,47 @@
+package schemas
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// dictionaryNameRegex matches a dictionary name accepted by the Fastly API.
+var dictionaryNameRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_ ]*$`)
+
+// Dictionaries returns the schema of the `dictionaries` service attribute,
+// which is registered by the dictionary nested resource (see registry.Register).
+func Dictionaries() schema.Attribute {
+	return schema.MapNestedAttribute{
+		MarkdownDescription: "Each key within the map should be a unique identifier for the resources contained within. A dictionary is a versioned container, its items are managed separately (using the `dictionary_id`) and aren't versioned. Changing only the key of a dictionary (and not its `name`) is a state-only change and doesn't delete and recreate the dictionary",
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"dictionary_id": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "Alphanumeric string identifying the dictionary, used to manage the dictionary items",
+					PlanModifiers: []planmodifier.String{
+						dictionaryID{},
+					},
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The name of the dictionary. It must start with a letter and can only contain letters, numbers, underscores and spaces. Renaming a dictionary keeps its items",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(dictionaryNameRegex, "must start with a letter and only contain letters, numbers, underscores and spaces"),
+					},
+				},
+				"write_only": schema.BoolAttribute{
+					Computed:            true,
+					MarkdownDescription: "Whether the dictionary items are hidden from the API and the Fastly UI (e.g. for secrets). The API doesn't allow this to be changed, so a change deletes and recreates the dictionary (which deletes its items). Default `false`",
+					Optional:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Domains returns the schema of the `domains` service attribute, which is
+// registered by the domain nested resource (see registry.Register).
+func Domains() schema.Attribute {
+	return schema.MapNestedAttribute{
+		MarkdownDescription: "Each key within the map should be a unique identifier for the resources contained within. Changing only the key of a domain (and not its `name`) is a state-only change and doesn't delete and recreate the domain. At least one domain is required unless `activate` is `false`",
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The domain that this Service will respond to. A wildcard (e.g. `*.example.com`) is only permitted as the leftmost label",
+					Required:            true,
+					Validators: []validator.String{
+						domainName{},
+					},
+				},
+				"comment": schema.StringAttribute{
+					MarkdownDescription: "An optional comment about the domain",
+					Optional:            true,
+				},
+				"created_at": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The date and time (RFC 3339) the domain was created. Refreshed when the service is read",
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"is_apex": schema.BoolAttribute{
+					Computed:            true,
+					MarkdownDescription: "Whether the domain is an apex domain (e.g. `example.com` but not `www.example.com`). An apex domain can't use a CNAME record to point at Fastly. Always `false` for a wildcard domain",
+					PlanModifiers: []planmodifier.Bool{
+						domainIsApex{},
+					},
+				},
+				"updated_at": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The date and time (RFC 3339) the domain was last updated. Refreshed when the service is read",
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// loggingEndpoint returns the schema of a logging endpoint service attribute
+// (e.g. `logging_kafka`), which is registered by a nested resource using the
+// logging package.
+//
+// The attributes common to every endpoint type (name, format, format_version
+// and placement) are merged with the endpoint specific attrs. The
+// response_condition attribute isn't supported by every endpoint type, so it's
+// included in attrs (see loggingResponseCondition).
+func loggingEndpoint(kind, description string, attrs map[string]schema.Attribute) schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"format": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "An Apache-style string or VCL variables to use for log formatting. Default `%h %l %u %t \"%r\" %>s %b`",
+			Optional:            true,
+			Default:             stringdefault.StaticString(`%h %l %u %t "%r" %>s %b`),
+		},
+		"format_version": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The version of the custom logging format used for the configured endpoint. Version `2` is recommended, version `1` is for endpoints configured before formats were versioned. Default `2`",
+			Optional:            true,
+			Default:             int64default.StaticInt64(2),
+			Validators: []validator.Int64{
+				int64validator.OneOf(1, 2),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the " + kind + " logging endpoint, which must be unique within the service version",
+			Required:            true,
+		},
+		"placement": schema.StringAttribute{
+			MarkdownDescription: "Where in the generated VCL the logging call should be placed. One of `none` or `waf_debug`. Defaults to the `vcl_log` subroutine",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("none", "waf_debug"),
+			},
+		},
+	}
+
+	for name, attr := range attrs {
+		attributes[name] = attr
+	}
+
+	return schema.MapNestedAttribute{
+		MarkdownDescription: "Each key within the map should be a unique identifier for the resources contained within. " + description + ". Changing only the key of an endpoint (and not its configuration) is a state-only change",
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: attributes,
+		},
+	}
+}
+
+// loggingResponseCondition returns the response_condition attribute of a
+// logging endpoint.
+func loggingResponseCondition() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The name of an existing condition in the configured endpoint, which controls when to log",
+		Optional:            true,
+	}
+}
@@ -0,0 +1,108 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// LoggingKafka returns the schema of the `logging_kafka` service attribute,
+// which is registered by the loggingkafka nested resource (see registry.Register).
+func LoggingKafka() schema.Attribute {
+	return loggingEndpoint("Kafka", "A Kafka logging endpoint streams logs to the topic of a Kafka cluster", map[string]schema.Attribute{
+		"auth_method": schema.StringAttribute{
+			MarkdownDescription: "The SASL authentication method. One of `plain`, `scram-sha-256` or `scram-sha-512`. Requires `user` and `password`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("plain", "scram-sha-256", "scram-sha-512"),
+				stringvalidator.AlsoRequires(
+					path.MatchRelative().AtParent().AtName("user"),
+					path.MatchRelative().AtParent().AtName("password"),
+				),
+			},
+		},
+		"brokers": schema.StringAttribute{
+			MarkdownDescription: "A comma-separated list of the Kafka brokers to connect to (e.g. `broker-1.example.com:9093,broker-2.example.com:9093`)",
+			Required:            true,
+		},
+		"compression_codec": schema.StringAttribute{
+			MarkdownDescription: "The codec used to compress the log messages. One of `gzip`, `snappy` or `lz4`. Defaults to no compression",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("gzip", "snappy", "lz4"),
+			},
+		},
+		"parse_log_keyvals": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Parses key/value pairs within the log format into the Kafka message headers. Default `false`",
+			Optional:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"password": schema.StringAttribute{
+			MarkdownDescription: "The SASL password",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"request_max_bytes": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The maximum number of bytes sent in one request to the brokers. Default `0` (no limit)",
+			Optional:            true,
+			Default:             int64default.StaticInt64(0),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"required_acks": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of acknowledgements a leader must receive before a write is considered successful. One of `1` (the leader), `0` (no response) or `-1` (all in-sync replicas). Default `1`",
+			Optional:            true,
+			Default:             int64default.StaticInt64(1),
+			Validators: []validator.Int64{
+				int64validator.OneOf(-1, 0, 1),
+			},
+		},
+		"response_condition": loggingResponseCondition(),
+		"tls_ca_cert": schema.StringAttribute{
+			MarkdownDescription: "A PEM-encoded CA certificate used to verify the brokers' certificates (if not signed by a public CA)",
+			Optional:            true,
+		},
+		"tls_client_cert": schema.StringAttribute{
+			MarkdownDescription: "A PEM-encoded client certificate used to authenticate with the brokers (mutual TLS). Requires `tls_client_key`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("tls_client_key")),
+			},
+		},
+		"tls_client_key": schema.StringAttribute{
+			MarkdownDescription: "The PEM-encoded private key of the client certificate. Requires `tls_client_cert`",
+			Optional:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("tls_client_cert")),
+			},
+		},
+		"tls_hostname": schema.StringAttribute{
+			MarkdownDescription: "The hostname used to verify the brokers' certificates (if it differs from the broker hostnames)",
+			Optional:            true,
+		},
+		"topic": schema.StringAttribute{
+			MarkdownDescription: "The Kafka topic to send the logs to",
+			Required:            true,
+		},
+		"use_tls": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether to use TLS for the connection to the brokers. Default `false`",
+			Optional:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"user": schema.StringAttribute{
+			MarkdownDescription: "The SASL user",
+			Optional:            true,
+			Sensitive:           true,
+		},
+	})
+}
@@ -0,0 +1,62 @@
+package schemas
+
+import (
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// LoggingKinesis returns the schema of the `logging_kinesis` service attribute,
+// which is registered by the loggingkinesis nested resource (see registry.Register).
+func LoggingKinesis() schema.Attribute {
+	regions := make([]string, 0, len(fastly.AllowedAwsRegionEnumValues))
+	for _, region := range fastly.AllowedAwsRegionEnumValues {
+		regions = append(regions, string(region))
+	}
+
+	return loggingEndpoint("Kinesis", "An Amazon Kinesis logging endpoint streams logs to a Kinesis data stream, authenticating with either an IAM role (`iam_role`) or an access key (`access_key` and `secret_key`)", map[string]schema.Attribute{
+		"access_key": schema.StringAttribute{
+			MarkdownDescription: "The AWS access key ID used to write to the stream. Requires `secret_key`, and conflicts with `iam_role`",
+			Optional:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_key")),
+				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("iam_role")),
+			},
+		},
+		"iam_role": schema.StringAttribute{
+			MarkdownDescription: "The ARN of an IAM role that Fastly assumes to write to the stream (see Fastly's guide on [creating an AWS IAM role](https://docs.fastly.com/en/guides/creating-an-aws-iam-role-for-fastly-logging)). Conflicts with `access_key` and `secret_key`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(
+					path.MatchRelative().AtParent().AtName("access_key"),
+					path.MatchRelative().AtParent().AtName("secret_key"),
+				),
+			},
+		},
+		"region": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The AWS region of the stream. Default `us-east-1`",
+			Optional:            true,
+			Default:             stringdefault.StaticString(string(fastly.AWSREGION_US_EAST_1)),
+			Validators: []validator.String{
+				stringvalidator.OneOf(regions...),
+			},
+		},
+		"secret_key": schema.StringAttribute{
+			MarkdownDescription: "The AWS secret access key used to write to the stream. Requires `access_key`, and conflicts with `iam_role`",
+			Optional:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("access_key")),
+			},
+		},
+		"topic": schema.StringAttribute{
+			MarkdownDescription: "The name of the Kinesis data stream to send the logs to",
+			Required:            true,
+		},
+	})
+}
@@ -0,0 +1,109 @@
+package schemas
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// domainIsApex is a plan modifier that sets the `is_apex` attribute of a domain
+// from its (sibling) `name` attribute, so the value is known during the plan.
+type domainIsApex struct{}
+
+func (m domainIsApex) Description(_ context.Context) string {
+	return "Set to whether the domain name is an apex domain."
+}
+
+func (m domainIsApex) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m domainIsApex) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	var name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, req.Path.ParentPath().AtName("name"), &name)...)
+	if resp.Diagnostics.HasError() || name.IsNull() || name.IsUnknown() {
+		return
+	}
+	resp.PlanValue = types.BoolValue(helpers.IsApexDomain(name.ValueString()))
+}
+
+// dictionaryID is a plan modifier that keeps the `dictionary_id` attribute of
+// a dictionary from the prior state, unless its (sibling) `write_only`
+// attribute has changed, in which case the dictionary is recreated and so the
+// ID is unknown until the apply.
+type dictionaryID struct{}
+
+func (m dictionaryID) Description(_ context.Context) string {
+	return "Use the prior state value, unless the dictionary is recreated."
+}
+
+func (m dictionaryID) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m dictionaryID) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var planWriteOnly, stateWriteOnly types.Bool
+	writeOnlyPath := req.Path.ParentPath().AtName("write_only")
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, writeOnlyPath, &planWriteOnly)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, writeOnlyPath, &stateWriteOnly)...)
+	if resp.Diagnostics.HasError() || planWriteOnly.IsUnknown() || !planWriteOnly.Equal(stateWriteOnly) {
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
+
+// IgnoreServerManagedSettings is the name of the attribute that enables the
+// ServerManaged plan modifier.
+const IgnoreServerManagedSettings = "ignore_server_managed_settings"
+
+// ServerManaged is a plan modifier for an optional attribute with a default,
+// whose value Fastly might manage (e.g. a setting default injected into a new
+// service version).
+//
+// If the attribute isn't configured and the `ignore_server_managed_settings`
+// attribute is `true`, then the prior state value (i.e. the value read from
+// the API) is planned instead of the default, so a value the user never set
+// doesn't cause a perpetual diff.
+type ServerManaged struct{}
+
+func (m ServerManaged) Description(_ context.Context) string {
+	return "If not configured and " + IgnoreServerManagedSettings + " is enabled, use the value read from the API instead of the default."
+}
+
+func (m ServerManaged) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m ServerManaged) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if m.useState(ctx, req.Config, req.ConfigValue, req.StateValue, &resp.Diagnostics) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func (m ServerManaged) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if m.useState(ctx, req.Config, req.ConfigValue, req.StateValue, &resp.Diagnostics) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// useState reports whether the prior state value should be planned.
+func (m ServerManaged) useState(ctx context.Context, config tfsdk.Config, configValue, stateValue attr.Value, diags *diag.Diagnostics) bool {
+	if !configValue.IsNull() || stateValue.IsNull() || stateValue.IsUnknown() {
+		return false
+	}
+
+	var enabled types.Bool
+	diags.Append(config.GetAttribute(ctx, path.Root(IgnoreServerManagedSettings), &enabled)...)
+	return !diags.HasError() && enabled.ValueBool()
+}
@@ -0,0 +1,164 @@
+package schemas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestServerManaged(t *testing.T) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"default_ttl":               schema.Int64Attribute{Optional: true},
+			IgnoreServerManagedSettings: schema.BoolAttribute{Optional: true},
+		},
+	}
+	configType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"default_ttl":               tftypes.Number,
+		IgnoreServerManagedSettings: tftypes.Bool,
+	}}
+
+	tests := map[string]struct {
+		ignore      any
+		configValue types.Int64
+		stateValue  types.Int64
+		want        types.Int64
+	}{
+		"ignored when not configured": {
+			ignore:      true,
+			configValue: types.Int64Null(),
+			stateValue:  types.Int64Value(7200),
+			want:        types.Int64Value(7200),
+		},
+		"default when not ignored": {
+			ignore:      false,
+			configValue: types.Int64Null(),
+			stateValue:  types.Int64Value(7200),
+			want:        types.Int64Value(3600),
+		},
+		"configured value when ignored": {
+			ignore:      true,
+			configValue: types.Int64Value(60),
+			stateValue:  types.Int64Value(7200),
+			want:        types.Int64Value(60),
+		},
+		"default when creating": {
+			ignore:      true,
+			configValue: types.Int64Null(),
+			stateValue:  types.Int64Null(),
+			want:        types.Int64Value(3600),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// The plan value is the configured value, or otherwise the default.
+			var ttl any
+			planValue := types.Int64Value(3600)
+			if !tc.configValue.IsNull() {
+				ttl = tc.configValue.ValueInt64()
+				planValue = tc.configValue
+			}
+			config := tfsdk.Config{
+				Schema: s,
+				Raw: tftypes.NewValue(configType, map[string]tftypes.Value{
+					"default_ttl":               tftypes.NewValue(tftypes.Number, ttl),
+					IgnoreServerManagedSettings: tftypes.NewValue(tftypes.Bool, tc.ignore),
+				}),
+			}
+
+			req := planmodifier.Int64Request{
+				Config:      config,
+				ConfigValue: tc.configValue,
+				PlanValue:   planValue,
+				StateValue:  tc.stateValue,
+			}
+			resp := &planmodifier.Int64Response{PlanValue: req.PlanValue}
+			ServerManaged{}.PlanModifyInt64(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tc.want) {
+				t.Errorf("want plan value %s, got: %s", tc.want, resp.PlanValue)
+			}
+		})
+	}
+}
+
+func TestDictionaryID(t *testing.T) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dictionary_id": schema.StringAttribute{Computed: true},
+			"write_only":    schema.BoolAttribute{Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"dictionary_id": tftypes.String,
+		"write_only":    tftypes.Bool,
+	}}
+
+	tests := map[string]struct {
+		planWriteOnly  bool
+		stateID        types.String
+		stateWriteOnly bool
+		want           types.String
+	}{
+		"prior state when unchanged": {
+			stateID: types.StringValue("abc"),
+			want:    types.StringValue("abc"),
+		},
+		"unknown when write_only changed": {
+			planWriteOnly: true,
+			stateID:       types.StringValue("abc"),
+			want:          types.StringUnknown(),
+		},
+		"unknown when creating": {
+			stateID: types.StringNull(),
+			want:    types.StringUnknown(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var stateID any
+			if !tc.stateID.IsNull() {
+				stateID = tc.stateID.ValueString()
+			}
+			req := planmodifier.StringRequest{
+				Path: path.Root("dictionary_id"),
+				Plan: tfsdk.Plan{
+					Schema: s,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"dictionary_id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"write_only":    tftypes.NewValue(tftypes.Bool, tc.planWriteOnly),
+					}),
+				},
+				PlanValue: types.StringUnknown(),
+				State: tfsdk.State{
+					Schema: s,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"dictionary_id": tftypes.NewValue(tftypes.String, stateID),
+						"write_only":    tftypes.NewValue(tftypes.Bool, tc.stateWriteOnly),
+					}),
+				},
+				StateValue: tc.stateID,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+			dictionaryID{}.PlanModifyString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tc.want) {
+				t.Errorf("want plan value %s, got: %s", tc.want, resp.PlanValue)
+			}
+		})
+	}
+}
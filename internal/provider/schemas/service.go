@@ -1,13 +1,51 @@
 package schemas
 
 import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
+// durationRegex matches a duration string accepted by time.ParseDuration.
+var durationRegex = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`)
+
+// timeout returns a timeouts attribute for the given operation.
+func timeout(operation string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The maximum duration of a " + operation + " operation, as a string of decimal numbers with a unit suffix (e.g. `30s`, `10m`, `1h30m`). Defaults to no timeout",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(durationRegex, "must be a duration such as 30s, 10m or 1h30m"),
+		},
+	}
+}
+
+// productEnablement returns an attribute that enables the given product.
+//
+// NOTE: There's no default, as a product might have been enabled outside of
+// Terraform (e.g. via the Fastly UI). So an unset attribute leaves the product
+// unmanaged (its state is read from the API), and only an explicit `false`
+// disables the product.
+func productEnablement(product string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Enables " + product + " for the service. This is a product enablement and is not versioned, so it takes effect immediately (regardless of `activate`). The product might first need to be purchased for the account. If unset, the product isn't managed by Terraform and is left as it is. Set to `false` to disable the product",
+		Optional:            true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
 // Service returns the common schema attributes between VCL/Compute services.
 //
 // NOTE: Some 'optional' attributes are also 'computed' so we can set a default.
@@ -17,44 +55,91 @@ import (
 // If we don't set a default, the Create/Update methods have to explicitly set a
 // value for the computed attributes. It's cleaner/easier to just set defaults.
 func Service() map[string]schema.Attribute {
-	return map[string]schema.Attribute{
+	attrs := map[string]schema.Attribute{
 		"activate": schema.BoolAttribute{
 			Computed:            true,
 			MarkdownDescription: "Conditionally prevents the Service from being activated. The apply step will continue to create a new draft version but will not activate it if this is set to `false`. Default `true`",
 			Optional:            true,
 			Default:             booldefault.StaticBool(true),
 		},
+		"active_traffic_threshold": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of requests per second (averaged over the last two minutes) above which `prevent_destroy_if_active_traffic` refuses to destroy the service. Default `0` (any traffic)",
+			Optional:            true,
+			Default:             int64default.StaticInt64(0),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"active_version_activated_by": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the user who activated the active service version (null if no version is active, or if the API token can't read the account event log)",
+		},
+		"active_version_created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time (RFC 3339) the active service version was created (null if no version is active)",
+		},
+		"active_version_updated_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time (RFC 3339) the active service version was last updated, which includes its activation (null if no version is active)",
+		},
+		"adopt_existing": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "If a service with the configured `name` already exists when the resource is created, adopts it into the Terraform state instead of creating a duplicate service. The active (or latest) version of the adopted service is cloned and reconciled with the configuration. Useful for bootstrapping Terraform over existing services. Default `false`",
+			Optional:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"bot_management":     productEnablement("Bot Management"),
+		"brotli_compression": productEnablement("Brotli compression (of responses for clients that support it)"),
+		"cloned_version": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The draft service version that was created (or modified) by the last apply. Useful for referencing the exact version to activate when `activate` is `false`",
+		},
 		"comment": schema.StringAttribute{
 			Computed:            true,
-			MarkdownDescription: "Description field for the service. Default `Managed by Terraform`",
+			MarkdownDescription: "Description field for the service. Set to an empty string (`\"\"`) to opt out of the default comment and remove any existing comment. Default `Managed by Terraform`",
 			Optional:            true,
 			Default:             stringdefault.StaticString("Managed by Terraform"),
 		},
-		"domains": schema.MapNestedAttribute{
-			MarkdownDescription: "Each key within the map should be a unique identifier for the resources contained within. It is important to note that changing the key will delete and recreate the resource",
-			Required:            true,
-			NestedObject: schema.NestedAttributeObject{
-				Attributes: map[string]schema.Attribute{
-					"name": schema.StringAttribute{
-						MarkdownDescription: "The domain that this Service will respond to",
-						Required:            true,
-					},
-					"comment": schema.StringAttribute{
-						MarkdownDescription: "An optional comment about the domain",
-						Optional:            true,
-					},
-				},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time (RFC 3339) the service was created",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"customer_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Alphanumeric string identifying the customer (account) that owns the service",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"domain_inspector": productEnablement("Domain Inspector (real-time metrics per domain)"),
+		"force":            BoolAlias("force_destroy"),
 		"force_destroy": schema.BoolAttribute{
+			Computed:            true,
 			MarkdownDescription: "Services that are active cannot be destroyed. In order to destroy the service, set `force_destroy` to `true`. Default `false`",
 			Optional:            true,
+			PlanModifiers: []planmodifier.Bool{
+				AliasedBy{Alias: "force"},
+			},
 		},
 		"force_refresh": schema.BoolAttribute{
 			Computed:            true,
 			Default:             booldefault.StaticBool(false),
 			MarkdownDescription: "Used internally by the provider to temporarily indicate if all resources should call their associated API to update the local state. This is for scenarios where the service version has been reverted outside of Terraform (e.g. via the Fastly UI) and the provider needs to resync the state for a different active version (this is only if `activate` is `true`)",
 		},
+		"http3": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Enables HTTP/3 (QUIC) support. This is a versioned setting, so a change requires a new service version (and is only live once `activate` is `true`). Default `false`",
+			Optional:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"has_unactivated_changes": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Indicates the service `version` differs from the last activated version (e.g. changes were applied with `activate` set to `false`). Useful for gating a later activation step on whether there is anything to deploy",
+		},
 		"id": schema.StringAttribute{
 			Computed:            true,
 			MarkdownDescription: "Alphanumeric string identifying the service",
@@ -64,6 +149,7 @@ func Service() map[string]schema.Attribute {
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"image_optimizer": productEnablement("Image Optimizer (image transformations using query parameters)"),
 		"imported": schema.BoolAttribute{
 			Computed:            true,
 			Default:             booldefault.StaticBool(false),
@@ -73,17 +159,77 @@ func Service() map[string]schema.Attribute {
 			Computed:            true,
 			MarkdownDescription: "The last 'active' service version (typically in-sync with `version` but not if `activate` is `false`)",
 		},
+		"lock_active_version": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Locks the service version once it has been activated so the deployed configuration cannot be edited outside of Terraform (e.g. via the Fastly UI). The next change made by Terraform will clone the locked version into a new draft version. Default `false`",
+			Optional:            true,
+			Default:             booldefault.StaticBool(false),
+		},
 		"name": schema.StringAttribute{
 			MarkdownDescription: "The unique name for the service to create",
 			Required:            true,
 		},
+		"origin_inspector": productEnablement("Origin Inspector (real-time metrics per origin)"),
+		"prevent_destroy_if_active_traffic": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Refuses to destroy (or deactivate with `reuse`) the service while the real-time stats show it receiving more than `active_traffic_threshold` requests per second. Set to `false` (and apply) to override. Default `false`",
+			Optional:            true,
+			Default:             booldefault.StaticBool(false),
+		},
 		"reuse": schema.BoolAttribute{
 			MarkdownDescription: "Services that are active cannot be destroyed. If set to `true` a service Terraform intends to destroy will instead be deactivated (allowing it to be reused by importing it into another Terraform project). If `false`, attempting to destroy an active service will cause an error. Default `false`",
 			Optional:            true,
 		},
+		"timeouts": schema.SingleNestedAttribute{
+			MarkdownDescription: "The maximum durations of the service operations. If an operation exceeds its timeout, the in-flight API call is cancelled and the apply fails",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"activate": schema.StringAttribute{
+					MarkdownDescription: "The maximum duration to retry an activation that conflicts with another in-flight activation (e.g. a concurrent apply), as a string of decimal numbers with a unit suffix (e.g. `30s`, `10m`). Defaults to five retries",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(durationRegex, "must be a duration such as 30s, 10m or 1h30m"),
+					},
+				},
+				"activate_poll_interval": schema.StringAttribute{
+					MarkdownDescription: "The delay before the first retry of a conflicting activation, which is doubled for each subsequent retry (up to 30s). Defaults to `2s`",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(durationRegex, "must be a duration such as 30s, 10m or 1h30m"),
+					},
+				},
+				"create": timeout("create"),
+				"delete": timeout("delete"),
+				"deployment": schema.StringAttribute{
+					MarkdownDescription: "The maximum duration to wait for an activated service version to be deployed when `wait_for_deployment` is `true`, as a string of decimal numbers with a unit suffix (e.g. `30s`, `10m`). Defaults to `10m`",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(durationRegex, "must be a duration such as 30s, 10m or 1h30m"),
+					},
+				},
+				"update": timeout("update"),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time (RFC 3339) the service was last updated (e.g. by a new service version or an activation). Refreshed when the service is read",
+		},
 		"version": schema.Int64Attribute{
 			Computed:            true,
 			MarkdownDescription: "The latest version that the provider will clone from (typically in-sync with `last_active` but not if `activate` is `false`)",
 		},
+		"wait_for_deployment": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "After activating a service version, waits until the version is reported as active and its generated VCL is available before the apply continues, so resources that depend on the service (e.g. smoke tests) run against the new configuration. See `timeouts.deployment`. Default `false`",
+			Optional:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"websockets": productEnablement("WebSockets passthrough"),
+	}
+
+	for name, attr := range ActivationWindow() {
+		attrs[name] = attr
 	}
+
+	return attrs
 }
@@ -0,0 +1,94 @@
+package schemas
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"golang.org/x/net/publicsuffix"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// hostnameLabelRegex matches a single label of a hostname.
+var hostnameLabelRegex = regexp.MustCompile(`^[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?$`)
+
+// domainName is a validator that checks a domain name is a valid hostname.
+//
+// NOTE: A wildcard (e.g. `*.example.com`) is only permitted as the leftmost
+// label, and must not cover an entire public suffix (e.g. `*.co.uk`).
+type domainName struct{}
+
+func (v domainName) Description(_ context.Context) string {
+	return "value must be a valid domain name (optionally with a leading `*.` wildcard)"
+}
+
+func (v domainName) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v domainName) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateDomainName(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Domain Name", fmt.Sprintf("%s: %s", v.Description(ctx), err))
+	}
+}
+
+func validateDomainName(name string) error {
+	if len(name) > 253 {
+		return fmt.Errorf("'%s' is longer than 253 characters", name)
+	}
+
+	host := name
+	if helpers.IsWildcardDomain(name) {
+		host = strings.TrimPrefix(name, "*.")
+		if _, err := publicsuffix.EffectiveTLDPlusOne(strings.ToLower(host)); err != nil {
+			return fmt.Errorf("the wildcard '%s' covers a public suffix", name)
+		}
+	}
+
+	labels := strings.Split(host, ".")
+	if len(labels) < 2 {
+		return fmt.Errorf("'%s' is not a fully qualified domain name", name)
+	}
+	for _, label := range labels {
+		if strings.Contains(label, "*") {
+			return fmt.Errorf("'%s' has a wildcard that isn't the leftmost label", name)
+		}
+		if !hostnameLabelRegex.MatchString(label) {
+			return fmt.Errorf("'%s' has an invalid label '%s'", name, label)
+		}
+	}
+	return nil
+}
+
+// timeZone is a validator that checks a time zone is in the IANA time zone
+// database (e.g. `Europe/London`).
+type timeZone struct{}
+
+func (v timeZone) Description(_ context.Context) string {
+	return "value must be an IANA time zone such as `UTC` or `Europe/London`"
+}
+
+func (v timeZone) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timeZone) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// NOTE: LoadLocation treats an empty name as UTC, and "Local" as the time
+	// zone of the machine running Terraform, neither of which is intended.
+	name := req.ConfigValue.ValueString()
+	if _, err := time.LoadLocation(name); err != nil || name == "" || name == "Local" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Time Zone", fmt.Sprintf("%s, got: '%s'", v.Description(ctx), name))
+	}
+}
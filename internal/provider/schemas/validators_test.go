@@ -0,0 +1,51 @@
+package schemas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateDomainName(t *testing.T) {
+	for name, valid := range map[string]bool{
+		"example.com":         true,
+		"www.example.co.uk":   true,
+		"*.example.com":       true,
+		"*.www.example.co.uk": true,
+		"*.co.uk":             false,
+		"*.com":               false,
+		"www.*.example.com":   false,
+		"*example.com":        false,
+		"**.example.com":      false,
+		"localhost":           false,
+		"-bad.example.com":    false,
+		"bad..example.com":    false,
+	} {
+		err := validateDomainName(name)
+		if valid && err != nil {
+			t.Errorf("expected '%s' to be valid, got error: %s", name, err)
+		}
+		if !valid && err == nil {
+			t.Errorf("expected '%s' to be invalid", name)
+		}
+	}
+}
+
+func TestTimeZone(t *testing.T) {
+	for name, valid := range map[string]bool{
+		"UTC":           true,
+		"Europe/London": true,
+		"Local":         false,
+		"":              false,
+		"Mars/Olympus":  false,
+	} {
+		req := validator.StringRequest{ConfigValue: types.StringValue(name)}
+		var resp validator.StringResponse
+		timeZone{}.ValidateString(context.Background(), req, &resp)
+		if got := !resp.Diagnostics.HasError(); got != valid {
+			t.Errorf("timeZone(%q) valid = %t, want %t", name, got, valid)
+		}
+	}
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
 )
 
 // TestAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -18,6 +20,15 @@ var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"fastly": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// TestAccProtoV6ProviderFactoriesWithMiddleware returns provider factories
+// whose API client sends all requests through the given middleware (e.g. to
+// record the API calls made by an acceptance test).
+func TestAccProtoV6ProviderFactoriesWithMiddleware(middleware ...helpers.Middleware) map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"fastly": providerserver.NewProtocol6WithError(New("test", middleware...)()),
+	}
+}
+
 func TestAccPreCheck(t *testing.T) {
 	// You can add code here to run prior to any test case execution, for example assertions
 	// about the appropriate environment variables being set are common to see in a pre-check
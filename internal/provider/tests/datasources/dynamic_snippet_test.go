@@ -0,0 +1,52 @@
+package datasources
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+// The following test validates a missing dynamic snippet is reported.
+//
+// NOTE: There is no snippet resource yet, so only the failure mode is tested.
+func TestAccDynamicSnippetDataSource(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("%s-tpff.integralist.co.uk", serviceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config:      testAccDynamicSnippetDataSourceConfig(serviceName, domainName),
+				ExpectError: regexp.MustCompile(`Unable to read dynamic snippet 'does-not-exist'`),
+			},
+		},
+	})
+}
+
+func testAccDynamicSnippetDataSourceConfig(serviceName, domainName string) string {
+	return fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      name = "%s"
+      force_destroy = true
+
+      domains = {
+        "example" = {
+          name = "%s"
+        },
+      }
+    }
+
+    data "fastly_dynamic_snippet" "test" {
+      service_id = fastly_service_vcl.test.id
+      snippet_id = "does-not-exist"
+    }
+  `, serviceName, domainName)
+}
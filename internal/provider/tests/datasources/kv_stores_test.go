@@ -0,0 +1,30 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+func TestAccKVStoresDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccKVStoresDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.fastly_kv_stores.test", "id"),
+					resource.TestCheckResourceAttrSet("data.fastly_kv_stores.test", "stores.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccKVStoresDataSourceConfig = `
+data "fastly_kv_stores" "test" {}
+`
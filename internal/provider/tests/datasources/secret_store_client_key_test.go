@@ -0,0 +1,33 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+func TestAccSecretStoreClientKeyDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSecretStoreClientKeyDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.fastly_secret_store_client_key.test", "client_key"),
+					resource.TestCheckResourceAttrSet("data.fastly_secret_store_client_key.test", "expires_at"),
+					resource.TestCheckResourceAttrSet("data.fastly_secret_store_client_key.test", "signature"),
+					resource.TestCheckResourceAttrSet("data.fastly_secret_store_client_key.test", "signing_key"),
+					resource.TestCheckResourceAttrPair("data.fastly_secret_store_client_key.test", "id", "data.fastly_secret_store_client_key.test", "client_key"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSecretStoreClientKeyDataSourceConfig = `
+data "fastly_secret_store_client_key" "test" {}
+`
@@ -0,0 +1,53 @@
+package datasources
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+func TestAccStatsDataSource(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("%s-tpff.integralist.co.uk", serviceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccStatsDataSourceConfig(serviceName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.fastly_stats.test", "service_id", "fastly_service_vcl.test", "id"),
+					resource.TestCheckResourceAttr("data.fastly_stats.test", "by", "hour"),
+					resource.TestCheckResourceAttrSet("data.fastly_stats.test", "stats.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStatsDataSourceConfig(serviceName, domainName string) string {
+	return fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      name = "%s"
+      force_destroy = true
+
+      domains = {
+        "example" = {
+          name = "%s"
+        },
+      }
+    }
+
+    data "fastly_stats" "test" {
+      service_id = fastly_service_vcl.test.id
+      from       = "1 day ago"
+      by         = "hour"
+    }
+  `, serviceName, domainName)
+}
@@ -0,0 +1,37 @@
+package datasources
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+// The following test validates the TLS certificate filters.
+//
+// NOTE: A match requires an uploaded certificate (and its private key) trusted by
+// Fastly, so only the failure modes are tested.
+func TestAccTLSCertificateDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate at least one filter is required.
+			{
+				Config:      `data "fastly_tls_certificate" "test" {}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Validate no matching certificate is reported.
+			{
+				Config: `
+          data "fastly_tls_certificate" "test" {
+            domain = "does-not-exist.tpff.integralist.co.uk"
+          }
+        `,
+				ExpectError: regexp.MustCompile(`No TLS certificate matches the given filters`),
+			},
+		},
+	})
+}
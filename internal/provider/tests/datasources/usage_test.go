@@ -0,0 +1,48 @@
+package datasources
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+func TestAccUsageDataSource(t *testing.T) {
+	now := time.Now().UTC()
+	year := now.Format("2006")
+	month := now.Format("01")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccUsageDataSourceConfig(year, month),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fastly_usage.test", "id", year+"-"+month),
+					resource.TestCheckResourceAttrSet("data.fastly_usage.test", "customer_id"),
+					resource.TestCheckResourceAttrSet("data.fastly_usage.test", "billing.cost"),
+				),
+			},
+			// Validate the month format
+			{
+				Config:      testAccUsageDataSourceConfig(year, "5"),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func testAccUsageDataSourceConfig(year, month string) string {
+	return fmt.Sprintf(`
+data "fastly_usage" "test" {
+  year  = "%s"
+  month = "%s"
+}
+`, year, month)
+}
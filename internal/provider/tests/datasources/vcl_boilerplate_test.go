@@ -0,0 +1,53 @@
+package datasources
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+func TestAccVCLBoilerplateDataSource(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("%s-tpff.integralist.co.uk", serviceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccVCLBoilerplateDataSourceConfig(serviceName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.fastly_vcl_boilerplate.test", "service_id", "fastly_service_vcl.test", "id"),
+					resource.TestCheckResourceAttr("data.fastly_vcl_boilerplate.test", "version", "1"),
+					resource.TestMatchResourceAttr("data.fastly_vcl_boilerplate.test", "content", regexp.MustCompile(`sub vcl_recv`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccVCLBoilerplateDataSourceConfig(serviceName, domainName string) string {
+	return fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      name = "%s"
+      force_destroy = true
+
+      domains = {
+        "example" = {
+          name = "%s"
+        },
+      }
+    }
+
+    data "fastly_vcl_boilerplate" "test" {
+      service_id = fastly_service_vcl.test.id
+      version    = fastly_service_vcl.test.version
+    }
+  `, serviceName, domainName)
+}
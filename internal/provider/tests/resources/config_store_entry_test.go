@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+// The following test validates the config store entry arguments.
+//
+// NOTE: There is no config store resource yet, so only the failure modes are tested.
+func TestAccResourceConfigStoreEntry(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate an empty key is rejected.
+			{
+				Config:      configConfigStoreEntry("abc", "", "value"),
+				ExpectError: regexp.MustCompile(`Attribute key string length must be between 1 and 256`),
+			},
+			// Validate an unknown config store is reported by the API.
+			{
+				Config:      configConfigStoreEntry("abc", "key", "value"),
+				ExpectError: regexp.MustCompile(`Unable to create config store entry`),
+			},
+		},
+	})
+}
+
+func configConfigStoreEntry(storeID, key, value string) string {
+	return fmt.Sprintf(`
+    resource "fastly_config_store_entry" "test" {
+      store_id = "%s"
+      key      = "%s"
+      value    = "%s"
+    }
+  `, storeID, key, value)
+}
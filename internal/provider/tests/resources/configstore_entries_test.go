@@ -0,0 +1,46 @@
+package resources
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+// The following test validates the config store entries arguments.
+//
+// NOTE: There is no config store resource yet, so only the failure modes are tested.
+func TestAccResourceConfigStoreEntries(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate an empty key is rejected.
+			{
+				Config:      configConfigStoreEntries("abc", ""),
+				ExpectError: regexp.MustCompile(`string length must be between 1 and 256`),
+			},
+			// Validate an unknown config store is reported.
+			{
+				Config:      configConfigStoreEntries("abc", "key"),
+				ExpectError: regexp.MustCompile(`Unable to (list|update) config store entries`),
+			},
+		},
+	})
+}
+
+func configConfigStoreEntries(storeID, key string) string {
+	return fmt.Sprintf(`
+    resource "fastly_configstore_entries" "test" {
+      store_id     = "%s"
+      manage_items = true
+
+      entries = {
+        "%s" = "value"
+      }
+    }
+  `, storeID, key)
+}
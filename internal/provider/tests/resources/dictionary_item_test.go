@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+// The following test validates the dictionary item arguments.
+//
+// NOTE: There is no dictionary resource yet, so only the failure modes are tested.
+func TestAccResourceDictionaryItem(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate an empty key is rejected.
+			{
+				Config:      configDictionaryItem("abc", "def", "", "value"),
+				ExpectError: regexp.MustCompile(`Attribute key string length must be between 1 and 256`),
+			},
+			// Validate an unknown dictionary is reported by the API.
+			{
+				Config:      configDictionaryItem("abc", "def", "key", "value"),
+				ExpectError: regexp.MustCompile(`Unable to create dictionary item`),
+			},
+		},
+	})
+}
+
+func configDictionaryItem(serviceID, dictionaryID, key, value string) string {
+	return fmt.Sprintf(`
+    resource "fastly_dictionary_item" "test" {
+      service_id    = "%s"
+      dictionary_id = "%s"
+      key           = "%s"
+      value         = "%s"
+    }
+  `, serviceID, dictionaryID, key, value)
+}
@@ -0,0 +1,47 @@
+package resources
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+// The following test validates Fanout can't be enabled for a VCL service.
+//
+// NOTE: There is no Compute service resource yet, so only the failure mode is tested.
+func TestAccResourceFanoutServiceTypeCheck(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("%s-tpff.integralist.co.uk", serviceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+          resource "fastly_service_vcl" "test" {
+            name = "%s"
+            force_destroy = true
+
+            domains = {
+              "example" = {
+                name = "%s"
+              },
+            }
+          }
+
+          resource "fastly_fanout" "test" {
+            service_id = fastly_service_vcl.test.id
+          }
+        `, serviceName, domainName),
+				ExpectError: regexp.MustCompile(`Fanout requires a Compute service`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,48 @@
+package resources
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+// The following test validates the KV store entry arguments.
+//
+// NOTE: There is no KV store resource yet, so only the failure modes are tested.
+func TestAccResourceKVStoreEntry(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate either `value` or `source` is required.
+			{
+				Config: `
+          resource "fastly_kv_store_entry" "test" {
+            store_id = "abc"
+            key      = "key"
+          }
+        `,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Validate an unknown store is reported by the API.
+			{
+				Config:      configKVStoreEntry("abc", "key", "value"),
+				ExpectError: regexp.MustCompile(`Unable to write the KV store entry`),
+			},
+		},
+	})
+}
+
+func configKVStoreEntry(storeID, key, value string) string {
+	return fmt.Sprintf(`
+    resource "fastly_kv_store_entry" "test" {
+      store_id = "%s"
+      key      = "%s"
+      value    = "%s"
+    }
+  `, storeID, key, value)
+}
@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+// The following test validates the package arguments are checked before any
+// upload is attempted.
+//
+// NOTE: A successful upload requires a Compute service and a compiled package.
+// There is no Compute service resource yet, so only the failure modes are tested.
+func TestAccResourcePackage(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate the service version must be a positive number.
+			{
+				Config:      configPackage("abc", 0, "package.tar.gz"),
+				ExpectError: regexp.MustCompile(`Attribute version value must be at least 1`),
+			},
+			// Validate a missing package file is reported before calling the API.
+			{
+				Config:      configPackage("abc", 1, "does-not-exist.tar.gz"),
+				ExpectError: regexp.MustCompile(`Unable to open package file`),
+			},
+		},
+	})
+}
+
+func configPackage(serviceID string, version int, filename string) string {
+	return fmt.Sprintf(`
+    resource "fastly_package" "test" {
+      service_id = "%s"
+      version    = %d
+      filename   = "%s"
+    }
+  `, serviceID, version, filename)
+}
@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+// The following test validates a purge is issued on create and re-issued when
+// the triggers change.
+func TestAccResourcePurge(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("%s-tpff.integralist.co.uk", serviceName)
+
+	var purgeID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: configPurge(serviceName, domainName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("fastly_purge.test", "service_id", "fastly_service_vcl.test", "id"),
+					resource.TestCheckResourceAttr("fastly_purge.test", "soft", "true"),
+					resource.TestCheckResourceAttr("fastly_purge.test", "surrogate_keys.#", "2"),
+					resource.TestCheckResourceAttrWith("fastly_purge.test", "id", func(value string) error {
+						purgeID = value
+						return nil
+					}),
+				),
+			},
+			// Changing the triggers should replace the resource (i.e. purge again).
+			{
+				Config: configPurge(serviceName, domainName, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_purge.test", "triggers.revision", "2"),
+					resource.TestCheckResourceAttrWith("fastly_purge.test", "id", func(value string) error {
+						if value == purgeID {
+							return fmt.Errorf("expected a new purge, got the same ID: %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			// Validate multiple purge types cannot be set.
+			{
+				Config: fmt.Sprintf(`
+          resource "fastly_purge" "test" {
+            service_id = "abc"
+            all        = true
+            url        = "%s/"
+          }
+        `, domainName),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Validate a URL purge isn't scoped to a service.
+			{
+				Config: fmt.Sprintf(`
+          resource "fastly_purge" "test" {
+            service_id = "abc"
+            url        = "%s/"
+          }
+        `, domainName),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Validate a URL with a scheme is rejected.
+			{
+				Config: fmt.Sprintf(`
+          resource "fastly_purge" "test" {
+            url = "https://%s/"
+          }
+        `, domainName),
+				ExpectError: regexp.MustCompile(`must be a host and path without a scheme`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func configPurge(serviceName, domainName, revision string) string {
+	return fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      name = "%s"
+      force_destroy = true
+
+      domains = {
+        "example" = {
+          name = "%s"
+        },
+      }
+    }
+
+    resource "fastly_purge" "test" {
+      service_id     = fastly_service_vcl.test.id
+      surrogate_keys = ["foo", "bar"]
+      soft           = true
+
+      triggers = {
+        revision = "%s"
+      }
+    }
+  `, serviceName, domainName, revision)
+}
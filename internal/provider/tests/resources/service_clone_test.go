@@ -0,0 +1,73 @@
+package resources
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+// The following test validates a service is copied into a new service, which
+// is then activated in a separate step.
+func TestAccResourceServiceClone(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("%s-tpff.integralist.co.uk", serviceName)
+	cloneDomainName := fmt.Sprintf("%s-clone-tpff.integralist.co.uk", serviceName)
+
+	configClone := func(cloneName string) string {
+		return fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      name          = "%s"
+      default_ttl   = 60
+      force_destroy = true
+
+      domains = {
+        "example" = {
+          name = "%s"
+        },
+      }
+    }
+
+    resource "fastly_service_clone" "test" {
+      name              = "%s"
+      source_service_id = fastly_service_vcl.test.id
+      force_destroy     = true
+
+      domains = ["%s"]
+    }
+
+    resource "fastly_service_promotion" "test" {
+      service_id = fastly_service_clone.test.id
+      version    = fastly_service_clone.test.version
+    }
+    `, serviceName, domainName, cloneName, cloneDomainName)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and activate the clone.
+			{
+				Config: configClone(serviceName + "-clone"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_clone.test", "source_version", "1"),
+					resource.TestCheckResourceAttr("fastly_service_clone.test", "version", "1"),
+					resource.TestCheckResourceAttr("fastly_service_clone.test", "comment", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("fastly_service_promotion.test", "active", "true"),
+				),
+			},
+			// Rename the clone in-place.
+			{
+				Config: configClone(serviceName + "-renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_clone.test", "name", serviceName+"-renamed"),
+					resource.TestCheckResourceAttr("fastly_service_clone.test", "version", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,95 @@
+package resources
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+// The following test validates a draft version staged by a service (with
+// `activate = false`) is promoted in a separate step.
+func TestAccResourceServicePromotion(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("%s-tpff.integralist.co.uk", serviceName)
+
+	configService := fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      name          = "%s"
+      activate      = false
+      force_destroy = true
+
+      domains = {
+        "example" = {
+          name = "%s"
+        },
+      }
+    }
+    `, serviceName, domainName)
+
+	configPromotion := configService + `
+    resource "fastly_service_promotion" "test" {
+      service_id = fastly_service_vcl.test.id
+      version    = fastly_service_vcl.test.cloned_version
+    }
+    `
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Build: stage the draft version.
+			{
+				Config: configService,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "has_unactivated_changes", "true"),
+				),
+			},
+			// Validate an unlocked version is rejected when a lock is required.
+			{
+				Config: configService + `
+          resource "fastly_service_promotion" "test" {
+            service_id     = fastly_service_vcl.test.id
+            version        = fastly_service_vcl.test.cloned_version
+            require_locked = true
+          }
+        `,
+				ExpectError: regexp.MustCompile(`the version is not locked`),
+			},
+			// Release: promote the draft version.
+			{
+				Config: configPromotion,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_promotion.test", "active", "true"),
+					resource.TestCheckResourceAttr("fastly_service_promotion.test", "version", "1"),
+					resource.TestCheckNoResourceAttr("fastly_service_promotion.test", "previous_version"),
+					resource.TestCheckResourceAttr("fastly_service_promotion.test", "validate", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "fastly_service_promotion.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccServicePromotionImportID("fastly_service_promotion.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"previous_version"},
+			},
+		},
+	})
+}
+
+// testAccServicePromotionImportID returns the SERVICE_ID/VERSION import ID.
+func testAccServicePromotionImportID(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		r, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", name)
+		}
+		return fmt.Sprintf("%s/%s", r.Primary.Attributes["service_id"], r.Primary.Attributes["version"]), nil
+	}
+}
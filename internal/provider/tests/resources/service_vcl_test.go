@@ -67,14 +67,28 @@ func TestAccResourceServiceVCLStandardBehaviours(t *testing.T) {
 				Config: configCreate,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("fastly_service_vcl.test", "activate", "true"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "adopt_existing", "false"),
+					resource.TestCheckResourceAttrSet("fastly_service_vcl.test", "active_version_created_at"),
+					resource.TestCheckResourceAttrSet("fastly_service_vcl.test", "active_version_updated_at"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "cloned_version", "1"),
 					resource.TestCheckResourceAttr("fastly_service_vcl.test", "comment", "Managed by Terraform"),
+					resource.TestCheckResourceAttrSet("fastly_service_vcl.test", "created_at"),
+					resource.TestCheckResourceAttrSet("fastly_service_vcl.test", "customer_id"),
 					resource.TestCheckResourceAttr("fastly_service_vcl.test", "default_ttl", "3600"),
 					resource.TestCheckResourceAttr("fastly_service_vcl.test", "domains.%", "2"),
 					resource.TestCheckResourceAttr("fastly_service_vcl.test", "domains.example-1.name", domain1Name),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "domains.example-1.is_apex", "false"),
+					resource.TestCheckResourceAttrSet("fastly_service_vcl.test", "domains.example-1.created_at"),
+					resource.TestCheckResourceAttrSet("fastly_service_vcl.test", "domains.example-1.updated_at"),
 					resource.TestCheckResourceAttr("fastly_service_vcl.test", "domains.example-2.name", domain2Name),
 					resource.TestCheckResourceAttr("fastly_service_vcl.test", "force_destroy", "false"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "has_unactivated_changes", "false"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "ignore_server_managed_settings", "false"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "prevent_destroy_if_active_traffic", "false"),
 					resource.TestCheckResourceAttr("fastly_service_vcl.test", "stale_if_error", "false"),
 					resource.TestCheckResourceAttr("fastly_service_vcl.test", "stale_if_error_ttl", "43200"),
+					resource.TestCheckResourceAttrSet("fastly_service_vcl.test", "updated_at"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "wait_for_deployment", "false"),
 					resource.TestCheckNoResourceAttr("fastly_service_vcl.test", "domains.example-1.comment"),
 					resource.TestCheckNoResourceAttr("fastly_service_vcl.test", "domains.example-2.comment"),
 				),
@@ -83,6 +97,7 @@ func TestAccResourceServiceVCLStandardBehaviours(t *testing.T) {
 			{
 				Config: configUpdate,
 				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "cloned_version", "2"),
 					resource.TestCheckResourceAttr("fastly_service_vcl.test", "force_destroy", "true"),
 					resource.TestCheckResourceAttr("fastly_service_vcl.test", "domains.example-1.comment", domain1CommentAdded),
 					resource.TestCheckResourceAttr("fastly_service_vcl.test", "domains.example-2.name", domain2NameUpdated),
@@ -99,7 +114,7 @@ func TestAccResourceServiceVCLStandardBehaviours(t *testing.T) {
 			//
 			// Also, the `force_destroy` attribute is set by a user in their config.
 			// If we had the import test before the 'update' test (where we set
-			// `force` to `true`), then we would have used the last known state value
+			// `force_destroy` to `true`), then we would have used the last known state value
 			// of `force_destroy = false` which would have prevented deleting the
 			// service in time for the import test to execute.
 			//
@@ -117,6 +132,10 @@ func TestAccResourceServiceVCLStandardBehaviours(t *testing.T) {
 			// test run we don't have that value set in the current state file because
 			// the default is only persisted to state after a plan/apply, and so the
 			// import test would fail suggesting that we're missing the attribute.
+			// The same is true for `lock_active_version` (default `false`).
+			//
+			// The `cloned_version` attribute is only known to the apply that created
+			// (or modified) the draft version, so it's not set when importing.
 			//
 			// The `reuse` field doesn't need to be ignored as it is optional and has
 			// no default value so essentially is null unless set explicitly by the
@@ -138,7 +157,7 @@ func TestAccResourceServiceVCLStandardBehaviours(t *testing.T) {
 				ResourceName:            "fastly_service_vcl.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"activate", "domain", "force_destroy", "last_active"},
+				ImportStateVerifyIgnore: []string{"activate", "activation_window_override", "adopt_existing", "active_traffic_threshold", "cloned_version", "domain", "force_destroy", "ignore_server_managed_settings", "last_active", "lock_active_version", "prevent_destroy_if_active_traffic", "wait_for_deployment"},
 				ImportStateCheck: func(is []*terraform.InstanceState) error {
 					for _, s := range is {
 						if numDomains, ok := s.Attributes["domains.%"]; ok {
@@ -191,6 +210,26 @@ func TestAccResourceServiceVCLInactiveVersionlessAttributeUpdates(t *testing.T)
     }
     `, serviceComment, serviceNameUpdated, domain1Name, domain2Name)
 
+	// Remove the comment by explicitly setting an empty string.
+	// This validates a user can opt out of the default comment.
+	configRemoveComment := fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      activate = false
+      comment = ""
+      name = "%s"
+      force_destroy = true
+
+      domains = {
+        "example-1" = {
+          name = "%s"
+        },
+        "example-2" = {
+          name = "%s"
+        },
+      }
+    }
+    `, serviceNameUpdated, domain1Name, domain2Name)
+
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { provider.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
@@ -213,6 +252,402 @@ func TestAccResourceServiceVCLInactiveVersionlessAttributeUpdates(t *testing.T)
 					resource.TestCheckResourceAttr("fastly_service_vcl.test", "name", serviceNameUpdated),
 				),
 			},
+			// Remove the comment and validate the API comment was cleared.
+			{
+				Config: configRemoveComment,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "comment", ""),
+					testAccCheckServiceVCLComment("fastly_service_vcl.test", ""),
+				),
+			},
+			// Delete testing automatically occurs at the end of the TestCase.
+		},
+	})
+}
+
+// The following test validates the service operation timeouts.
+func TestAccResourceServiceVCLTimeouts(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain1Name := fmt.Sprintf("%s-tpff-1.integralist.co.uk", serviceName)
+	domain2Name := fmt.Sprintf("%s-tpff-2.integralist.co.uk", serviceName)
+
+	configTimeouts := func(create, update, deleteTimeout string) string {
+		return fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      activate = false
+      force_destroy = true
+      name = "%s"
+
+      domains = {
+        "example-1" = {
+          name = "%s"
+        },
+        "example-2" = {
+          name = "%s"
+        },
+      }
+
+      timeouts = {
+        activate               = "5m"
+        activate_poll_interval = "5s"
+        create = "%s"
+        update = "%s"
+        delete = "%s"
+        deployment = "5m"
+      }
+    }
+    `, serviceName, domain1Name, domain2Name, create, update, deleteTimeout)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate an invalid duration is rejected.
+			{
+				Config:      configTimeouts("ten minutes", "10m", "10m"),
+				ExpectError: regexp.MustCompile(`must be a duration such as 30s, 10m or 1h30m`),
+			},
+			// Create and Read testing
+			{
+				Config: configTimeouts("10m", "10m", "10m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "timeouts.activate", "5m"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "timeouts.activate_poll_interval", "5s"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "timeouts.create", "10m"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "timeouts.update", "10m"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "timeouts.delete", "10m"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "timeouts.deployment", "5m"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: configTimeouts("10m", "1h30m", "5m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "timeouts.update", "1h30m"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "timeouts.delete", "5m"),
+				),
+			},
+			// Delete testing automatically occurs at the end of the TestCase.
+		},
+	})
+}
+
+// The following test validates the WebSockets product can be toggled.
+// Removing the attribute from the config leaves the product as it is.
+func TestAccResourceServiceVCLWebSockets(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain1Name := fmt.Sprintf("%s-tpff-1.integralist.co.uk", serviceName)
+	domain2Name := fmt.Sprintf("%s-tpff-2.integralist.co.uk", serviceName)
+
+	configWebSockets := func(websockets string) string {
+		return fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      activate = false
+      force_destroy = true
+      name = "%s"
+      %s
+
+      domains = {
+        "example-1" = {
+          name = "%s"
+        },
+        "example-2" = {
+          name = "%s"
+        },
+      }
+    }
+    `, serviceName, websockets, domain1Name, domain2Name)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: configWebSockets("websockets = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "websockets", "true"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "brotli_compression", "false"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "image_optimizer", "false"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "has_unactivated_changes", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "fastly_service_vcl.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activate", "activation_window_override", "adopt_existing", "active_traffic_threshold", "cloned_version", "domain", "force_destroy", "ignore_server_managed_settings", "last_active", "lock_active_version", "prevent_destroy_if_active_traffic", "wait_for_deployment"},
+			},
+			// Unset the attribute (which leaves the product enabled)
+			{
+				Config: configWebSockets(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "websockets", "true"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: configWebSockets("websockets = false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "websockets", "false"),
+				),
+			},
+			// Delete testing automatically occurs at the end of the TestCase.
+		},
+	})
+}
+
+// The following test validates the dictionaries nested attribute.
+// A renamed dictionary is updated, while a write_only change recreates it.
+func TestAccResourceServiceVCLDictionaries(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("%s-tpff-1.integralist.co.uk", serviceName)
+
+	configDictionaries := func(dictionaryName string, writeOnly bool) string {
+		return fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      activate = false
+      force_destroy = true
+      name = "%s"
+
+      dictionaries = {
+        "config" = {
+          name = "%s"
+          write_only = %t
+        },
+      }
+
+      domains = {
+        "example-1" = {
+          name = "%s"
+        },
+      }
+    }
+    `, serviceName, dictionaryName, writeOnly, domainName)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: configDictionaries("config", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "dictionaries.%", "1"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "dictionaries.config.name", "config"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "dictionaries.config.write_only", "false"),
+					resource.TestCheckResourceAttrSet("fastly_service_vcl.test", "dictionaries.config.dictionary_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "fastly_service_vcl.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activate", "activation_window_override", "adopt_existing", "active_traffic_threshold", "cloned_version", "dictionaries", "domains", "force_destroy", "ignore_server_managed_settings", "last_active", "lock_active_version", "prevent_destroy_if_active_traffic", "wait_for_deployment"},
+			},
+			// Rename the dictionary
+			{
+				Config: configDictionaries("settings", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "dictionaries.config.name", "settings"),
+					resource.TestCheckResourceAttrSet("fastly_service_vcl.test", "dictionaries.config.dictionary_id"),
+				),
+			},
+			// Recreate the dictionary as write-only
+			{
+				Config: configDictionaries("settings", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "dictionaries.config.write_only", "true"),
+					resource.TestCheckResourceAttrSet("fastly_service_vcl.test", "dictionaries.config.dictionary_id"),
+				),
+			},
+			// Delete testing automatically occurs at the end of the TestCase.
+		},
+	})
+}
+
+// The following test validates the logging_kafka nested attribute.
+// A modified endpoint is recreated, as the API client can't update it.
+func TestAccResourceServiceVCLLoggingKafka(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("%s-tpff-1.integralist.co.uk", serviceName)
+
+	configKafka := func(topic string) string {
+		return fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      activate = false
+      force_destroy = true
+      name = "%s"
+
+      domains = {
+        "example-1" = {
+          name = "%s"
+        },
+      }
+
+      logging_kafka = {
+        "kafka" = {
+          auth_method = "scram-sha-256"
+          brokers = "broker-1.example.com:9093,broker-2.example.com:9093"
+          name = "kafka"
+          password = "secret"
+          topic = "%s"
+          use_tls = true
+          user = "fastly"
+        },
+      }
+    }
+    `, serviceName, domainName, topic)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: configKafka("logs"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "logging_kafka.%", "1"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "logging_kafka.kafka.topic", "logs"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "logging_kafka.kafka.format_version", "2"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "logging_kafka.kafka.required_acks", "1"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "logging_kafka.kafka.use_tls", "true"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: configKafka("access-logs"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "logging_kafka.kafka.topic", "access-logs"),
+				),
+			},
+			// Delete testing automatically occurs at the end of the TestCase.
+		},
+	})
+}
+
+// The following test validates the logging_kinesis nested attribute.
+func TestAccResourceServiceVCLLoggingKinesis(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("%s-tpff-1.integralist.co.uk", serviceName)
+
+	configKinesis := func(region string) string {
+		return fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      activate = false
+      force_destroy = true
+      name = "%s"
+
+      domains = {
+        "example-1" = {
+          name = "%s"
+        },
+      }
+
+      logging_kinesis = {
+        "kinesis" = {
+          iam_role = "arn:aws:iam::123456789012:role/fastly-logging"
+          name = "kinesis"
+          region = "%s"
+          topic = "logs"
+        },
+      }
+    }
+    `, serviceName, domainName, region)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: configKinesis("eu-west-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "logging_kinesis.%", "1"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "logging_kinesis.kinesis.region", "eu-west-1"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "logging_kinesis.kinesis.format_version", "2"),
+					resource.TestCheckNoResourceAttr("fastly_service_vcl.test", "logging_kinesis.kinesis.access_key"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: configKinesis("us-west-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "logging_kinesis.kinesis.region", "us-west-2"),
+				),
+			},
+			// Delete testing automatically occurs at the end of the TestCase.
+		},
+	})
+}
+
+// The following test validates a service can be drafted without any domains
+// (but not activated).
+func TestAccResourceServiceVCLNoDomains(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("%s-tpff-1.integralist.co.uk", serviceName)
+
+	configDraft := fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      activate = false
+      force_destroy = true
+      name = "%s"
+    }
+    `, serviceName)
+
+	configActivateWithoutDomains := fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      force_destroy = true
+      name = "%s"
+    }
+    `, serviceName)
+
+	configActivate := fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      force_destroy = true
+      name = "%s"
+
+      domains = {
+        "example-1" = {
+          name = "%s"
+        },
+      }
+    }
+    `, serviceName, domainName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: configDraft,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "activate", "false"),
+					resource.TestCheckNoResourceAttr("fastly_service_vcl.test", "active_version_created_at"),
+					resource.TestCheckNoResourceAttr("fastly_service_vcl.test", "domains.%"),
+				),
+			},
+			// Activation requires a domain
+			{
+				Config:      configActivateWithoutDomains,
+				ExpectError: regexp.MustCompile("At least one domain is required to activate a service"),
+			},
+			// Update and Read testing
+			{
+				Config: configActivate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "activate", "true"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "domains.%", "1"),
+				),
+			},
 			// Delete testing automatically occurs at the end of the TestCase.
 		},
 	})
@@ -292,6 +727,45 @@ func TestAccResourceServiceVCLDeletedAtCheck(t *testing.T) {
 	})
 }
 
+// The following test validates the service not found behaviour.
+// i.e. if the API returns a 404, then remove the service resource.
+// e.g. importing a service ID that doesn't exist.
+func TestAccResourceServiceVCLNotFound(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain1Name := fmt.Sprintf("%s-tpff-1.integralist.co.uk", serviceName)
+	domain2Name := fmt.Sprintf("%s-tpff-2.integralist.co.uk", serviceName)
+
+	configCreate := configServiceVCLCreate(configServiceVCLCreateOpts{
+		activate:     false,
+		forceDestroy: true,
+		serviceName:  serviceName,
+		domain1Name:  domain1Name,
+		domain2Name:  domain2Name,
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// We need a resource to be created by Terraform so we can import into it.
+			{
+				Config: configCreate,
+			},
+			// ImportState testing
+			//
+			// Read removes the resource from the state when the service isn't found.
+			// Terraform then reports the import as a non-existent remote object.
+			{
+				ResourceName:  "fastly_service_vcl.test",
+				ImportState:   true,
+				ImportStateId: "doesnotexist" + acctest.RandString(10),
+				ExpectError:   regexp.MustCompile(`Cannot import non-existent remote object`),
+			},
+			// Delete testing automatically occurs at the end of the TestCase.
+		},
+	})
+}
+
 // The following test validates the service type import behaviour.
 // i.e. when importing a service, check the service type matches the resource.
 // e.g. importing a Compute service ID into a VCL service resource.
@@ -417,10 +891,11 @@ func TestAccResourceServiceVCLImportServiceVersion(t *testing.T) {
 // i.e. we're allowing for `version` attribute to drift from `last_active`.
 //
 // The second scenario is when we create a service with `activate=false`, so we
-// have a non-active version 1. We then make an update which triggers the
-// service version to be cloned (resulting in a non-active version 2). Because
-// there is no active service version, we'll track service version 2 while
-// last_active will be null as there is no prior active service version.
+// have a non-active version 1. We then make an update which, because version
+// 1 is still an unlocked draft, is applied to version 1 in place rather than
+// cloning a new version. Because there is no active service version, we'll
+// track service version 1 while last_active will be null as there is no prior
+// active service version.
 //
 // The third scenario is when the user has multiple active service versions but
 // they need to manually revert the service version via the UI and so the next
@@ -471,8 +946,8 @@ func TestAccResourceServiceVCLStateDrift(t *testing.T) {
     `, serviceName, domain1Name, domain1CommentAdded, domain2Name)
 
 	// Update the first domain's comment.
-	// This will result in a new service version that's inactive.
-	// We want Terraform to be tracking this version and not the prior inactive version.
+	// The service version is an unlocked draft so it will be modified in place.
+	// We want Terraform to keep tracking this version rather than cloning.
 	configUpdate2 := fmt.Sprintf(`
     resource "fastly_service_vcl" "test" {
       activate = false
@@ -553,7 +1028,7 @@ func TestAccResourceServiceVCLStateDrift(t *testing.T) {
 				// service version (which is version 1) and so we explicitly add
 				// `version` to the ImportStateVerifyIgnore list and instead use
 				// `ImportStateCheck` to validate the value is `1`.
-				ImportStateVerifyIgnore: []string{"activate", "domain", "force_destroy", "last_active", "version"},
+				ImportStateVerifyIgnore: []string{"activate", "cloned_version", "domain", "force_destroy", "last_active", "lock_active_version", "version"},
 				ImportStateCheck: func(is []*terraform.InstanceState) error {
 					for _, s := range is {
 						if version, ok := s.Attributes["version"]; ok && version != "1" {
@@ -580,7 +1055,7 @@ func TestAccResourceServiceVCLStateDrift(t *testing.T) {
 				Config: configUpdate2,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckNoResourceAttr("fastly_service_vcl.test", "last_active"), // expect `last_active` to be null
-					resource.TestCheckResourceAttr("fastly_service_vcl.test", "version", "2"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "version", "1"),  // expect the draft to be reused rather than cloned
 				),
 			},
 			// ImportState testing
@@ -590,15 +1065,15 @@ func TestAccResourceServiceVCLStateDrift(t *testing.T) {
 			// i.e. If `activate=false` then `last_active` is never set.
 			//
 			// Terraform's import test behaviour is to compare the imported state to
-			// the previous state, so as the last step test found `version` to be 2,
+			// the previous state, so as the last step test found `version` to be 1,
 			// this means we expect the imported state to match because the latest
-			// service version is 2 and that's what the import logic selected as there
+			// service version is 1 and that's what the import logic selected as there
 			// was no prior active service version.
 			{
 				ResourceName:            "fastly_service_vcl.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"activate", "domain", "force_destroy"},
+				ImportStateVerifyIgnore: []string{"activate", "cloned_version", "domain", "force_destroy", "lock_active_version"},
 			},
 			// Delete resource by emptying the TF config
 			{
@@ -664,6 +1139,195 @@ func TestAccResourceServiceVCLStateDrift(t *testing.T) {
 	})
 }
 
+// The following test validates that a change to the service settings results
+// in a new service version, while a versionless change (e.g. `comment`) does not.
+func TestAccResourceServiceVCLSettingsUpdate(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain1Name := fmt.Sprintf("%s-tpff-1.integralist.co.uk", serviceName)
+	domain2Name := fmt.Sprintf("%s-tpff-2.integralist.co.uk", serviceName)
+
+	configCreate := configServiceVCLCreate(configServiceVCLCreateOpts{
+		activate:     true,
+		forceDestroy: true,
+		serviceName:  serviceName,
+		domain1Name:  domain1Name,
+		domain2Name:  domain2Name,
+	})
+
+	configTemplate := `
+    resource "fastly_service_vcl" "test" {
+      comment = "%s"
+      default_ttl = %d
+      force_destroy = true
+      http3 = %t
+      name = "%s"
+
+      domains = {
+        "example-1" = {
+          name = "%s"
+        },
+        "example-2" = {
+          name = "%s"
+        },
+      }
+    }
+    `
+	configUpdateSettings := fmt.Sprintf(configTemplate, "Managed by Terraform", 60, false, serviceName, domain1Name, domain2Name)
+	configUpdateComment := fmt.Sprintf(configTemplate, "an updated comment", 60, false, serviceName, domain1Name, domain2Name)
+	configUpdateHTTP3 := fmt.Sprintf(configTemplate, "an updated comment", 60, true, serviceName, domain1Name, domain2Name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: configCreate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "default_ttl", "3600"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "version", "1"),
+				),
+			},
+			// Update settings (expect a new service version)
+			{
+				Config: configUpdateSettings,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "default_ttl", "60"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "last_active", "2"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "version", "2"),
+				),
+			},
+			// Update versionless attribute (expect no new service version)
+			{
+				Config: configUpdateComment,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "comment", "an updated comment"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "version", "2"),
+				),
+			},
+			// Enable HTTP/3 (a versioned setting, so expect a new service version)
+			{
+				Config: configUpdateHTTP3,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "http3", "true"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "last_active", "3"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "version", "3"),
+				),
+			},
+			// Delete testing automatically occurs at the end of the TestCase.
+		},
+	})
+}
+
+// The following test validates the `lock_active_version` behaviour.
+// i.e. an activated version is locked, and a subsequent change is applied to
+// a newly cloned version (which is then activated and locked).
+func TestAccResourceServiceVCLLockActiveVersion(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("%s-tpff-1.integralist.co.uk", serviceName)
+	domainComment := "an added comment"
+
+	configCreate := fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      force_destroy = true
+      lock_active_version = true
+      name = "%s"
+
+      domains = {
+        "example-1" = {
+          name = "%s"
+        },
+      }
+    }
+    `, serviceName, domainName)
+
+	configUpdate := fmt.Sprintf(`
+    resource "fastly_service_vcl" "test" {
+      force_destroy = true
+      lock_active_version = true
+      name = "%s"
+
+      domains = {
+        "example-1" = {
+          name = "%s"
+          comment = "%s"
+        },
+      }
+    }
+    `, serviceName, domainName, domainComment)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: configCreate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "lock_active_version", "true"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "last_active", "1"),
+					testAccCheckServiceVCLVersionLocked("fastly_service_vcl.test", 1),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: configUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "domains.example-1.comment", domainComment),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "last_active", "2"),
+					resource.TestCheckResourceAttr("fastly_service_vcl.test", "version", "2"),
+					testAccCheckServiceVCLVersionLocked("fastly_service_vcl.test", 2),
+				),
+			},
+			// Delete testing automatically occurs at the end of the TestCase.
+		},
+	})
+}
+
+// testAccCheckServiceVCLVersionLocked validates the service version is locked.
+func testAccCheckServiceVCLVersionLocked(name string, version int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		r, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		apiClient := fastly.NewAPIClient(fastly.NewConfiguration())
+		ctx := fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv)
+		clientReq := apiClient.VersionAPI.GetServiceVersion(ctx, r.Primary.ID, version)
+		clientResp, httpResp, err := clientReq.Execute()
+		if err != nil {
+			return fmt.Errorf("failed to get service version %d: %w", version, err)
+		}
+		defer httpResp.Body.Close()
+		if !clientResp.GetLocked() {
+			return fmt.Errorf("expected service version %d to be locked", version)
+		}
+		return nil
+	}
+}
+
+// testAccCheckServiceVCLComment validates the service comment via the Fastly API.
+func testAccCheckServiceVCLComment(name, comment string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		r, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		apiClient := fastly.NewAPIClient(fastly.NewConfiguration())
+		ctx := fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv)
+		clientReq := apiClient.ServiceAPI.GetServiceDetail(ctx, r.Primary.ID)
+		clientResp, httpResp, err := clientReq.Execute()
+		if err != nil {
+			return fmt.Errorf("failed to get service details: %w", err)
+		}
+		defer httpResp.Body.Close()
+		if got := clientResp.GetComment(); got != comment {
+			return fmt.Errorf("expected service comment %q, got: %q", comment, got)
+		}
+		return nil
+	}
+}
+
 type configServiceVCLCreateOpts struct {
 	activate, forceDestroy                bool
 	serviceName, domain1Name, domain2Name string
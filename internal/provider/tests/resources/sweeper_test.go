@@ -0,0 +1,137 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/fastly/fastly-go/fastly"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
+)
+
+// testResourcePrefix is the name prefix used by all acceptance test resources.
+const testResourcePrefix = "tf-test-"
+
+// TestMain enables the sweepers to be run with `go test -sweep=all`.
+//
+// The sweepers delete any resources left behind by failed acceptance test runs.
+// The `-sweep` flag value is required by the test framework but is otherwise
+// ignored as Fastly has no concept of regions.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("fastly_service_vcl", &resource.Sweeper{
+		Name: "fastly_service_vcl",
+		F:    sweepServices,
+	})
+	resource.AddTestSweepers("fastly_kv_store", &resource.Sweeper{
+		Name: "fastly_kv_store",
+		F:    sweepKVStores,
+	})
+}
+
+// sweepServices deactivates and deletes all test services.
+func sweepServices(_ string) error {
+	client := fastly.NewAPIClient(fastly.NewConfiguration())
+	ctx := fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv)
+
+	var services []fastly.ServiceListResponse
+	for page := int32(1); ; page++ {
+		clientReq := client.ServiceAPI.ListServices(ctx)
+		clientReq.Page(page)
+		clientReq.PerPage(100)
+		clientResp, httpResp, err := clientReq.Execute()
+		if err != nil {
+			return fmt.Errorf("failed to list services: %w", err)
+		}
+		httpResp.Body.Close()
+		services = append(services, clientResp...)
+		if len(clientResp) < 100 {
+			break
+		}
+	}
+
+	var errs []string
+	for _, service := range services {
+		if !strings.HasPrefix(service.GetName(), testResourcePrefix) {
+			continue
+		}
+		id := service.GetID()
+
+		for _, version := range service.GetVersions() {
+			if !version.GetActive() {
+				continue
+			}
+			clientReq := client.VersionAPI.DeactivateServiceVersion(ctx, id, version.GetNumber())
+			_, httpResp, err := clientReq.Execute()
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("failed to deactivate service %s: %s", id, err))
+				continue
+			}
+			httpResp.Body.Close()
+		}
+
+		clientReq := client.ServiceAPI.DeleteService(ctx, id)
+		_, httpResp, err := clientReq.Execute()
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("failed to delete service %s: %s", id, err))
+			continue
+		}
+		httpResp.Body.Close()
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// sweepKVStores deletes all test KV stores.
+func sweepKVStores(_ string) error {
+	client := fastly.NewAPIClient(fastly.NewConfiguration())
+	ctx := fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv)
+
+	var (
+		cursor string
+		errs   []string
+	)
+	for {
+		clientReq := client.KvStoreAPI.GetStores(ctx)
+		if cursor != "" {
+			clientReq = *clientReq.Cursor(cursor)
+		}
+		clientResp, httpResp, err := clientReq.Execute()
+		if err != nil {
+			return fmt.Errorf("failed to list KV stores: %w", err)
+		}
+		httpResp.Body.Close()
+
+		for _, store := range clientResp.GetData() {
+			if !strings.HasPrefix(store.GetName(), testResourcePrefix) {
+				continue
+			}
+			clientReq := client.KvStoreAPI.DeleteStore(ctx, store.GetID())
+			httpResp, err := clientReq.Execute()
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("failed to delete KV store %s: %s", store.GetID(), err))
+				continue
+			}
+			httpResp.Body.Close()
+		}
+
+		meta := clientResp.GetMeta()
+		cursor = meta.GetNextCursor()
+		if cursor == "" {
+			break
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package resources
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
+)
+
+// The following test validates the TLS certificate arguments.
+//
+// NOTE: A successful upload requires a certificate (and its private key)
+// trusted by Fastly, so only the failure modes are tested.
+func TestAccResourceTLSCertificate(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate the certificate must be PEM-encoded.
+			{
+				Config: `
+          resource "fastly_tls_certificate" "test" {
+            certificate_blob = "not a certificate"
+          }
+        `,
+				ExpectError: regexp.MustCompile(`must be a PEM-encoded certificate`),
+			},
+		},
+	})
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
+	"github.com/fastly/fastly-go/fastly"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
+	"github.com/integralist/terraform-provider-fastly-framework/internal/exporter"
+	"github.com/integralist/terraform-provider-fastly-framework/internal/helpers"
 	"github.com/integralist/terraform-provider-fastly-framework/internal/provider"
 )
 
@@ -28,11 +32,30 @@ var version = "dev"
 // commit  string = ""
 
 func main() {
-	var debug bool
+	var (
+		debug         bool
+		exportService string
+		exportVersion int
+	)
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.StringVar(&exportService, "export-service", "", "print the fastly_service_vcl configuration for an existing service ID and exit (requires "+helpers.APIKeyEnv+")")
+	flag.IntVar(&exportVersion, "export-version", 0, "the service version to export (defaults to the active version)")
 	flag.Parse()
 
+	if exportService != "" {
+		api := helpers.API{
+			Client:    fastly.NewAPIClient(fastly.NewConfiguration()),
+			ClientCtx: fastly.NewAPIKeyContextFromEnv(helpers.APIKeyEnv),
+		}
+		hcl, err := exporter.ServiceVCL(api, exportService, int32(exportVersion))
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		_, _ = os.Stdout.Write(hcl)
+		return
+	}
+
 	opts := providerserver.ServeOpts{
 		Address: "registry.terraform.io/integralist/fastly-framework",
 		Debug:   debug,